@@ -0,0 +1,166 @@
+package localdate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	ErrNonexistentDate = errors.New("localdate: day does not exist in the resulting month")
+	ErrInfiniteDate    = errors.New("localdate: date is infinite")
+	ErrInvalidDate     = errors.New("localdate: date is invalid")
+	ErrDateOutOfRange  = errors.New("localdate: date is out of range")
+)
+
+// maxMonthsDelta bounds the months AddMonths computes with. It is well beyond
+// the roughly 11.7 million years a LocalDate spans, so larger values are out of
+// range anyway and the int64 arithmetic cannot overflow.
+const maxMonthsDelta = 1 << 28
+
+// OverflowPolicy decides what AddMonths and AddYears do when the day of month
+// does not exist in the resulting month, e.g. for 2024-01-31 plus one month.
+type OverflowPolicy int
+
+const (
+	// OverflowClamp uses the last day of the resulting month, so
+	// 2024-01-31 plus one month is 2024-02-29.
+	OverflowClamp OverflowPolicy = iota
+	// OverflowCarry carries the excess days into the following month like
+	// AddDate and time.Time.AddDate, so 2024-01-31 plus one month is 2024-03-02.
+	OverflowCarry
+	// OverflowError returns ErrNonexistentDate.
+	OverflowError
+	// OverflowEndOfMonth keeps month-ends at month-ends and otherwise clamps,
+	// so 2024-02-29 plus one month is 2024-03-31 and 2024-01-30 plus one
+	// month is 2024-02-29.
+	OverflowEndOfMonth
+)
+
+// AddMonths returns d with months calendar months added, resolving days that do
+// not exist in the resulting month according to policy. Infinite and invalid
+// dates are returned unchanged. It returns ErrDateOutOfRange if the result
+// cannot be represented as a finite LocalDate.
+func (d LocalDate) AddMonths(months int, policy OverflowPolicy) (LocalDate, error) {
+	if !d.IsFinite() {
+		return d, nil
+	}
+	if months > maxMonthsDelta || months < -maxMonthsDelta {
+		return LocalDate{}, fmt.Errorf("%w: %v plus %d months", ErrDateOutOfRange, d, months)
+	}
+	year, month, day := d.Date()
+	total := int64(year)*12 + int64(month-1) + int64(months)
+	newYear, newMonth := floorDiv(total, 12), time.Month(floorMod(total, 12)+1)
+	last := daysInMonth(newYear, newMonth)
+
+	switch policy {
+	case OverflowClamp:
+		day = min(day, last)
+	case OverflowCarry:
+		// daysFromCivil needs a normalized day, add the excess afterwards
+		return fromDays(daysFromCivil(newYear, newMonth, 1) + int64(day-1))
+	case OverflowError:
+		if day > last {
+			return LocalDate{}, fmt.Errorf("%w: %04d-%02d-%02d", ErrNonexistentDate, newYear, newMonth, day)
+		}
+	case OverflowEndOfMonth:
+		if day == daysInMonth(int64(year), month) {
+			day = last
+		}
+		day = min(day, last)
+	default:
+		return LocalDate{}, fmt.Errorf("localdate: unknown overflow policy %d", policy)
+	}
+	return fromDays(daysFromCivil(newYear, newMonth, day))
+}
+
+// fromDays returns the finite date days after 1970-01-01, or ErrDateOutOfRange
+// if it does not fit in a LocalDate or would be one of the infinities.
+func fromDays(days int64) (LocalDate, error) {
+	if days <= math.MinInt32 || days >= math.MaxInt32 {
+		year, month, day := civilFromDays(days)
+		return LocalDate{}, fmt.Errorf("%w: %d-%02d-%02d", ErrDateOutOfRange, year, month, day)
+	}
+	return LocalDate{Days: int32(days), Valid: true}, nil
+}
+
+// AddYears returns d with years calendar years added, resolving February 29 in
+// non-leap years according to policy. Infinite and invalid dates are returned
+// unchanged. It returns ErrDateOutOfRange if the result cannot be represented
+// as a finite LocalDate.
+func (d LocalDate) AddYears(years int, policy OverflowPolicy) (LocalDate, error) {
+	if d.IsFinite() && (years > maxMonthsDelta/12 || years < -maxMonthsDelta/12) {
+		return LocalDate{}, fmt.Errorf("%w: %v plus %d years", ErrDateOutOfRange, d, years)
+	}
+	return d.AddMonths(years*12, policy)
+}
+
+// checkFinite returns ErrInvalidDate or ErrInfiniteDate for the first date
+// that is not finite.
+func checkFinite(dates ...LocalDate) error {
+	for _, d := range dates {
+		switch {
+		case !d.Valid:
+			return ErrInvalidDate
+		case !d.IsFinite():
+			return fmt.Errorf("%w: %v", ErrInfiniteDate, d)
+		}
+	}
+	return nil
+}
+
+// DaysBetween returns the number of days from a to b, negative if b is before
+// a. It returns ErrInfiniteDate or ErrInvalidDate if either date is not finite.
+func DaysBetween(a, b LocalDate) (int, error) {
+	if err := checkFinite(a, b); err != nil {
+		return 0, err
+	}
+	return int(b.Days) - int(a.Days), nil
+}
+
+// MonthsBetween returns the number of whole months from a to b, truncated
+// toward zero. A month is complete once the day of month of a is reached, so
+// from 2024-01-15 it is one month to 2024-02-15 but zero to 2024-02-14, and
+// from 2023-01-31 it is zero months to 2023-02-28. This matches PostgreSQL's
+// age() and java.time. It returns ErrInfiniteDate or ErrInvalidDate if either
+// date is not finite.
+//
+// Between instead counts months the way AddDate adds them, so that
+// a.Plus(Between(a, b)) is b. The two agree when the day of month of a is at
+// most 28. Otherwise Between can be one month closer to zero, e.g. from
+// 2023-01-31 to 2023-03-01 MonthsBetween is 1 but Between is P29D.
+func MonthsBetween(a, b LocalDate) (int, error) {
+	if err := checkFinite(a, b); err != nil {
+		return 0, err
+	}
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	months := (by-ay)*12 + int(bm-am)
+	switch {
+	case months > 0 && bd < ad:
+		months--
+	case months < 0 && bd > ad:
+		months++
+	}
+	return months, nil
+}
+
+// YearsBetween returns the number of whole years from a to b, truncated toward
+// zero, with the same rules as MonthsBetween.
+func YearsBetween(a, b LocalDate) (int, error) {
+	months, err := MonthsBetween(a, b)
+	return months / 12, err
+}
+
+// Age returns the age in whole years on the date on of someone born on birth.
+// Someone born on February 29 turns a year older on March 1 in common years.
+func Age(birth, on LocalDate) (int, error) {
+	if err := checkFinite(birth, on); err != nil {
+		return 0, err
+	}
+	if IsAfter(birth, on) {
+		return 0, fmt.Errorf("localdate: birth date %v is after %v", birth, on)
+	}
+	return YearsBetween(birth, on)
+}
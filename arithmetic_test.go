@@ -0,0 +1,380 @@
+package localdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    LocalDate
+		months  int
+		policy  OverflowPolicy
+		want    LocalDate
+		wantErr error
+	}{
+		{
+			name:   "clamp - January 31 + 1 month in leap year",
+			date:   NewLocalDate(2024, time.January, 31),
+			months: 1,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2024, time.February, 29),
+		},
+		{
+			name:   "clamp - January 31 + 1 month",
+			date:   NewLocalDate(2023, time.January, 31),
+			months: 1,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2023, time.February, 28),
+		},
+		{
+			name:   "clamp - March 31 - 1 month",
+			date:   NewLocalDate(2023, time.March, 31),
+			months: -1,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2023, time.February, 28),
+		},
+		{
+			name:   "clamp - day exists",
+			date:   NewLocalDate(2023, time.May, 15),
+			months: 2,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2023, time.July, 15),
+		},
+		{
+			name:   "clamp - does not keep month end",
+			date:   NewLocalDate(2023, time.February, 28),
+			months: 1,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2023, time.March, 28),
+		},
+		{
+			name:   "carry - January 31 + 1 month",
+			date:   NewLocalDate(2023, time.January, 31),
+			months: 1,
+			policy: OverflowCarry,
+			want:   NewLocalDate(2023, time.March, 3), // same as AddDate
+		},
+		{
+			name:   "carry - January 31 + 1 month in leap year",
+			date:   NewLocalDate(2024, time.January, 31),
+			months: 1,
+			policy: OverflowCarry,
+			want:   NewLocalDate(2024, time.March, 2),
+		},
+		{
+			name:    "error - January 31 + 1 month",
+			date:    NewLocalDate(2024, time.January, 31),
+			months:  1,
+			policy:  OverflowError,
+			wantErr: ErrNonexistentDate,
+		},
+		{
+			name:   "error - day exists",
+			date:   NewLocalDate(2024, time.January, 29),
+			months: 1,
+			policy: OverflowError,
+			want:   NewLocalDate(2024, time.February, 29),
+		},
+		{
+			name:   "end of month - February 29 + 1 month",
+			date:   NewLocalDate(2024, time.February, 29),
+			months: 1,
+			policy: OverflowEndOfMonth,
+			want:   NewLocalDate(2024, time.March, 31),
+		},
+		{
+			name:   "end of month - April 30 + 1 month",
+			date:   NewLocalDate(2024, time.April, 30),
+			months: 1,
+			policy: OverflowEndOfMonth,
+			want:   NewLocalDate(2024, time.May, 31),
+		},
+		{
+			name:   "end of month - January 31 + 1 month",
+			date:   NewLocalDate(2023, time.January, 31),
+			months: 1,
+			policy: OverflowEndOfMonth,
+			want:   NewLocalDate(2023, time.February, 28),
+		},
+		{
+			name:   "end of month - January 30 + 1 month clamps",
+			date:   NewLocalDate(2024, time.January, 30),
+			months: 1,
+			policy: OverflowEndOfMonth,
+			want:   NewLocalDate(2024, time.February, 29),
+		},
+		{
+			name:   "end of month - May 30 + 1 month is not a month end",
+			date:   NewLocalDate(2024, time.May, 30),
+			months: 1,
+			policy: OverflowEndOfMonth,
+			want:   NewLocalDate(2024, time.June, 30),
+		},
+		{
+			name:   "end of month - backwards",
+			date:   NewLocalDate(2024, time.June, 30),
+			months: -1,
+			policy: OverflowEndOfMonth,
+			want:   NewLocalDate(2024, time.May, 31),
+		},
+		{
+			name:   "months causing year overflow",
+			date:   NewLocalDate(2023, time.November, 15),
+			months: 3,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2024, time.February, 15),
+		},
+		{
+			name:   "negative months causing year underflow",
+			date:   NewLocalDate(2023, time.December, 31),
+			months: -24,
+			policy: OverflowClamp,
+			want:   NewLocalDate(2021, time.December, 31),
+		},
+		{
+			name:   "infinity remains infinity",
+			date:   InfinityDate(),
+			months: 1,
+			policy: OverflowError,
+			want:   InfinityDate(),
+		},
+		{
+			name:   "negative infinity remains negative infinity",
+			date:   NegInfinityDate(),
+			months: 1,
+			policy: OverflowClamp,
+			want:   NegInfinityDate(),
+		},
+		{
+			name:    "huge month count is out of range",
+			date:    NewLocalDate(2024, time.January, 31),
+			months:  1 << 40,
+			policy:  OverflowClamp,
+			wantErr: ErrDateOutOfRange,
+		},
+		{
+			name:    "past the last representable date",
+			date:    NewLocalDate(2024, time.January, 31),
+			months:  12 * 5_900_000,
+			policy:  OverflowCarry,
+			wantErr: ErrDateOutOfRange,
+		},
+		{
+			name:    "before the first representable date",
+			date:    NewLocalDate(2024, time.January, 31),
+			months:  -12 * 5_900_000,
+			policy:  OverflowEndOfMonth,
+			wantErr: ErrDateOutOfRange,
+		},
+		{
+			name:   "far but in range",
+			date:   NewLocalDate(2024, time.January, 31),
+			months: 12 * 5_000_000,
+			policy: OverflowError,
+			want:   NewLocalDate(5_002_024, time.January, 31),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.date.AddMonths(tt.months, tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddMonths(%d) error = %v, want %v", tt.months, err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !IsEqual(got, tt.want) {
+				t.Errorf("AddMonths(%d) = %v, want %v", tt.months, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	leapDay := NewLocalDate(2020, time.February, 29)
+
+	tests := []struct {
+		name    string
+		policy  OverflowPolicy
+		years   int
+		want    LocalDate
+		wantErr error
+	}{
+		{name: "clamp", policy: OverflowClamp, years: 1, want: NewLocalDate(2021, time.February, 28)},
+		{name: "carry", policy: OverflowCarry, years: 1, want: NewLocalDate(2021, time.March, 1)},
+		{name: "error", policy: OverflowError, years: 1, wantErr: ErrNonexistentDate},
+		{name: "end of month", policy: OverflowEndOfMonth, years: 1, want: NewLocalDate(2021, time.February, 28)},
+		{name: "to leap year", policy: OverflowError, years: 4, want: NewLocalDate(2024, time.February, 29)},
+		{name: "backwards", policy: OverflowClamp, years: -1, want: NewLocalDate(2019, time.February, 28)},
+		{name: "out of range", policy: OverflowClamp, years: 6_000_000, wantErr: ErrDateOutOfRange},
+		{name: "overflowing months", policy: OverflowClamp, years: 1 << 60, wantErr: ErrDateOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := leapDay.AddYears(tt.years, tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddYears(%d) error = %v, want %v", tt.years, err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !IsEqual(got, tt.want) {
+				t.Errorf("AddYears(%d) = %v, want %v", tt.years, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := leapDay.AddYears(1, OverflowPolicy(42)); err == nil {
+		t.Errorf("Expected error for unknown policy")
+	}
+}
+
+func TestAddMonthsCarryMatchesAddDate(t *testing.T) {
+	from := NewLocalDate(2019, time.January, 1)
+	to := NewLocalDate(2021, time.December, 31)
+	for d := from; !IsAfter(d, to); d = AddDays(d, 1) {
+		for months := -25; months <= 25; months++ {
+			got, err := d.AddMonths(months, OverflowCarry)
+			if err != nil {
+				t.Fatalf("AddMonths(%v, %d) error = %v", d, months, err)
+			}
+			if want := d.AddDate(0, months, 0); got != want {
+				t.Fatalf("AddMonths(%v, %d) = %v, want %v", d, months, got, want)
+			}
+		}
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       LocalDate
+		b       LocalDate
+		want    int
+		wantErr error
+	}{
+		{name: "same date", a: NewLocalDate(2023, time.May, 15), b: NewLocalDate(2023, time.May, 15), want: 0},
+		{name: "forward", a: NewLocalDate(2023, time.May, 15), b: NewLocalDate(2024, time.May, 15), want: 366},
+		{name: "backward", a: NewLocalDate(2023, time.May, 15), b: NewLocalDate(2023, time.May, 10), want: -5},
+		{name: "across epoch", a: NewLocalDate(1969, time.December, 31), b: NewLocalDate(1970, time.January, 2), want: 2},
+		{name: "infinity", a: NewLocalDate(2023, time.May, 15), b: InfinityDate(), wantErr: ErrInfiniteDate},
+		{name: "negative infinity", a: NegInfinityDate(), b: NewLocalDate(2023, time.May, 15), wantErr: ErrInfiniteDate},
+		{name: "invalid", a: LocalDate{}, b: NewLocalDate(2023, time.May, 15), wantErr: ErrInvalidDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DaysBetween(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DaysBetween(%v, %v) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DaysBetween(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthsAndYearsBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		a          LocalDate
+		b          LocalDate
+		wantMonths int
+		wantYears  int
+		wantErr    error
+	}{
+		{name: "same date", a: NewLocalDate(2023, time.May, 15), b: NewLocalDate(2023, time.May, 15)},
+		{name: "one day short of a month", a: NewLocalDate(2024, time.January, 15), b: NewLocalDate(2024, time.February, 14), wantMonths: 0},
+		{name: "exactly a month", a: NewLocalDate(2024, time.January, 15), b: NewLocalDate(2024, time.February, 15), wantMonths: 1},
+		{name: "month end to shorter month end", a: NewLocalDate(2023, time.January, 31), b: NewLocalDate(2023, time.February, 28), wantMonths: 0},
+		{name: "month end to next month start", a: NewLocalDate(2023, time.January, 31), b: NewLocalDate(2023, time.March, 1), wantMonths: 1},
+		{name: "one day short of a year", a: NewLocalDate(2023, time.May, 15), b: NewLocalDate(2024, time.May, 14), wantMonths: 11, wantYears: 0},
+		{name: "exactly a year", a: NewLocalDate(2023, time.May, 15), b: NewLocalDate(2024, time.May, 15), wantMonths: 12, wantYears: 1},
+		{name: "several years", a: NewLocalDate(2000, time.March, 1), b: NewLocalDate(2023, time.February, 28), wantMonths: 275, wantYears: 22},
+		{name: "backward", a: NewLocalDate(2024, time.March, 15), b: NewLocalDate(2024, time.January, 20), wantMonths: -1},
+		{name: "backward exactly", a: NewLocalDate(2024, time.March, 15), b: NewLocalDate(2023, time.March, 15), wantMonths: -12, wantYears: -1},
+		{name: "infinity", a: NewLocalDate(2023, time.May, 15), b: InfinityDate(), wantErr: ErrInfiniteDate},
+		{name: "invalid", a: NewLocalDate(2023, time.May, 15), b: LocalDate{}, wantErr: ErrInvalidDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			months, err := MonthsBetween(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MonthsBetween(%v, %v) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if months != tt.wantMonths {
+				t.Errorf("MonthsBetween(%v, %v) = %v, want %v", tt.a, tt.b, months, tt.wantMonths)
+			}
+			years, err := YearsBetween(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("YearsBetween(%v, %v) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if years != tt.wantYears {
+				t.Errorf("YearsBetween(%v, %v) = %v, want %v", tt.a, tt.b, years, tt.wantYears)
+			}
+		})
+	}
+}
+
+// TestMonthsBetweenAgainstBetween pins how MonthsBetween relates to the months
+// of Between: equal up to day 28, otherwise at most one month further from zero.
+func TestMonthsBetweenAgainstBetween(t *testing.T) {
+	from := NewLocalDate(2023, time.January, 1)
+	to := NewLocalDate(2024, time.December, 31)
+	for a := from; !IsAfter(a, to); a = AddDays(a, 1) {
+		for offset := -400; offset <= 400; offset += 3 {
+			b := AddDays(a, offset)
+			months, err := MonthsBetween(a, b)
+			if err != nil {
+				t.Fatalf("MonthsBetween(%v, %v) error = %v", a, b, err)
+			}
+			diff := months - Between(a, b).TotalMonths()
+			if offset < 0 {
+				diff = -diff
+			}
+			if diff < 0 || diff > 1 || a.Day() <= 28 && diff != 0 {
+				t.Fatalf("MonthsBetween(%v, %v) = %v, Between() = %v", a, b, months, Between(a, b))
+			}
+		}
+	}
+
+	a, b := NewLocalDate(2023, time.January, 31), NewLocalDate(2023, time.March, 1)
+	if months, _ := MonthsBetween(a, b); months != 1 || Between(a, b) != NewPeriod(0, 0, 29) {
+		t.Errorf("MonthsBetween(%v, %v) = %v, Between() = %v, want 1 and P29D", a, b, months, Between(a, b))
+	}
+}
+
+func TestAge(t *testing.T) {
+	leapling := NewLocalDate(2000, time.February, 29)
+
+	tests := []struct {
+		name    string
+		birth   LocalDate
+		on      LocalDate
+		want    int
+		wantErr bool
+	}{
+		{name: "day before birthday", birth: NewLocalDate(1990, time.June, 15), on: NewLocalDate(2023, time.June, 14), want: 32},
+		{name: "on birthday", birth: NewLocalDate(1990, time.June, 15), on: NewLocalDate(2023, time.June, 15), want: 33},
+		{name: "on birth date", birth: NewLocalDate(1990, time.June, 15), on: NewLocalDate(1990, time.June, 15), want: 0},
+		{name: "leapling on February 28 in common year", birth: leapling, on: NewLocalDate(2001, time.February, 28), want: 0},
+		{name: "leapling on March 1 in common year", birth: leapling, on: NewLocalDate(2001, time.March, 1), want: 1},
+		{name: "leapling on February 28 in leap year", birth: leapling, on: NewLocalDate(2004, time.February, 28), want: 3},
+		{name: "leapling on February 29 in leap year", birth: leapling, on: NewLocalDate(2004, time.February, 29), want: 4},
+		{name: "born after date", birth: NewLocalDate(2023, time.June, 15), on: NewLocalDate(2023, time.June, 14), wantErr: true},
+		{name: "infinite date", birth: NewLocalDate(1990, time.June, 15), on: InfinityDate(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Age(tt.birth, tt.on)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Age(%v, %v) error = %v, wantErr %v", tt.birth, tt.on, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Age(%v, %v) = %v, want %v", tt.birth, tt.on, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package localdate
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const binaryVersion byte = 1
+
+// kinds of dates in the binary encoding
+const (
+	binaryInvalid byte = iota
+	binaryFinite
+	binaryInfinity
+	binaryNegInfinity
+)
+
+// AppendBinary implements encoding.BinaryAppender.
+//
+// The encoding is a version byte, a kind byte telling invalid, finite,
+// infinity and negative infinity apart and, for finite dates only, the days
+// since 1970-01-01 as a signed varint. Dates within a few centuries of the
+// epoch take 4 or 5 bytes.
+func (d LocalDate) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, binaryVersion)
+	switch {
+	case !d.Valid:
+		return append(b, binaryInvalid), nil
+	case d.IsInfinity():
+		return append(b, binaryInfinity), nil
+	case d.IsNegInfinity():
+		return append(b, binaryNegInfinity), nil
+	default:
+		b = append(b, binaryFinite)
+		return binary.AppendVarint(b, int64(d.Days)), nil
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, see AppendBinary for the
+// format.
+func (d LocalDate) MarshalBinary() ([]byte, error) {
+	return d.AppendBinary(make([]byte, 0, 7))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *LocalDate) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("LocalDate.UnmarshalBinary: no data")
+	}
+	if data[0] != binaryVersion {
+		return errors.New("LocalDate.UnmarshalBinary: unsupported version")
+	}
+	if len(data) < 2 {
+		return errors.New("LocalDate.UnmarshalBinary: invalid length")
+	}
+
+	var parsed LocalDate
+	rest := data[2:]
+	switch data[1] {
+	case binaryInvalid:
+	case binaryInfinity:
+		parsed = InfinityDate()
+	case binaryNegInfinity:
+		parsed = NegInfinityDate()
+	case binaryFinite:
+		days, n := binary.Varint(rest)
+		if n <= 0 {
+			return errors.New("LocalDate.UnmarshalBinary: invalid days")
+		}
+		if days <= math.MinInt32 || days >= math.MaxInt32 {
+			return errors.New("LocalDate.UnmarshalBinary: days out of range")
+		}
+		parsed = LocalDate{Days: int32(days), Valid: true}
+		rest = rest[n:]
+	default:
+		return errors.New("LocalDate.UnmarshalBinary: unknown kind")
+	}
+	if len(rest) != 0 {
+		return errors.New("LocalDate.UnmarshalBinary: invalid length")
+	}
+
+	*d = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the binary encoding.
+func (d LocalDate) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder using the binary encoding.
+func (d *LocalDate) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
@@ -0,0 +1,116 @@
+package localdate
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		date LocalDate
+		want []byte
+	}{
+		{name: "epoch", date: NewLocalDate(1970, time.January, 1), want: []byte{1, 1, 0}},
+		{name: "day before epoch", date: NewLocalDate(1969, time.December, 31), want: []byte{1, 1, 1}},
+		{name: "regular date", date: NewLocalDate(2023, time.May, 15), want: []byte{1, 1, 0xc8, 0xb0, 0x02}},
+		{name: "min date", date: NewLocalDate(1, time.January, 1)},
+		{name: "max date", date: NewLocalDate(9999, time.December, 31)},
+		{name: "infinity", date: InfinityDate(), want: []byte{1, 2}},
+		{name: "negative infinity", date: NegInfinityDate(), want: []byte{1, 3}},
+		{name: "invalid", date: LocalDate{Days: 42}, want: []byte{1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.date.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			if tt.want != nil && !bytes.Equal(data, tt.want) {
+				t.Errorf("MarshalBinary() = %v, want %v", data, tt.want)
+			}
+			appended, err := tt.date.AppendBinary([]byte{0xff})
+			if err != nil {
+				t.Fatalf("AppendBinary() error = %v", err)
+			}
+			if !bytes.Equal(appended[1:], data) {
+				t.Errorf("AppendBinary() = %v, want %v", appended[1:], data)
+			}
+
+			want := tt.date
+			if !want.Valid {
+				want = LocalDate{}
+			}
+			got := NewLocalDate(2000, time.January, 1)
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if got != want {
+				t.Errorf("UnmarshalBinary() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "no data", data: nil},
+		{name: "unsupported version", data: []byte{2, 1, 0}},
+		{name: "missing kind", data: []byte{1}},
+		{name: "unknown kind", data: []byte{1, 4}},
+		{name: "missing days", data: []byte{1, 1}},
+		{name: "truncated days", data: []byte{1, 1, 0xc8}},
+		{name: "days out of range", data: []byte{1, 1, 0x80, 0x80, 0x80, 0x80, 0x20}},
+		{name: "trailing data", data: []byte{1, 2, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewLocalDate(2023, time.May, 15)
+			if err := d.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary(%v) = %v, want error", tt.data, d)
+			}
+			if want := NewLocalDate(2023, time.May, 15); d != want {
+				t.Errorf("UnmarshalBinary(%v) modified date to %v", tt.data, d)
+			}
+		})
+	}
+}
+
+func TestGob(t *testing.T) {
+	type cached struct {
+		Name  string
+		From  LocalDate
+		To    LocalDate
+		Dates []LocalDate
+	}
+	want := cached{
+		Name:  "subscription",
+		From:  NewLocalDate(2023, time.May, 15),
+		To:    InfinityDate(),
+		Dates: []LocalDate{NegInfinityDate(), {}, NewLocalDate(1969, time.December, 31)},
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var got cached
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got.Name != want.Name || got.From != want.From || got.To != want.To || len(got.Dates) != len(want.Dates) {
+		t.Fatalf("Decode() = %v, want %v", got, want)
+	}
+	for i := range want.Dates {
+		if got.Dates[i] != want.Dates[i] {
+			t.Errorf("Decode() Dates[%d] = %v, want %v", i, got.Dates[i], want.Dates[i])
+		}
+	}
+}
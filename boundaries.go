@@ -0,0 +1,112 @@
+package localdate
+
+import "time"
+
+// EndOfWeek returns the last day of the week containing d, for weeks starting
+// on firstDay. Infinite and invalid dates are returned unchanged.
+func (d LocalDate) EndOfWeek(firstDay time.Weekday) LocalDate {
+	return d.OnOrAfter((firstDay + 6) % 7)
+}
+
+// StartOfMonth returns the first day of the month of d. Infinite and invalid
+// dates are returned unchanged.
+func (d LocalDate) StartOfMonth() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return d.YearMonth().FirstDay()
+}
+
+// EndOfMonth returns the last day of the month of d. Infinite and invalid
+// dates are returned unchanged.
+func (d LocalDate) EndOfMonth() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return d.YearMonth().LastDay()
+}
+
+// StartOfQuarter returns the first day of the quarter of d. Infinite and
+// invalid dates are returned unchanged.
+func (d LocalDate) StartOfQuarter() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return d.Quarter().FirstDay()
+}
+
+// EndOfQuarter returns the last day of the quarter of d. Infinite and invalid
+// dates are returned unchanged.
+func (d LocalDate) EndOfQuarter() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return d.Quarter().LastDay()
+}
+
+// StartOfHalfYear returns the first day of the half-year of d. Infinite and
+// invalid dates are returned unchanged.
+func (d LocalDate) StartOfHalfYear() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return d.HalfYear().FirstDay()
+}
+
+// EndOfHalfYear returns the last day of the half-year of d. Infinite and
+// invalid dates are returned unchanged.
+func (d LocalDate) EndOfHalfYear() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return d.HalfYear().LastDay()
+}
+
+// StartOfYear returns January 1 of the year of d. Infinite and invalid dates
+// are returned unchanged.
+func (d LocalDate) StartOfYear() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return LocalDate{Days: int32(daysFromCivil(int64(d.Year()), time.January, 1)), Valid: true}
+}
+
+// EndOfYear returns December 31 of the year of d. Infinite and invalid dates
+// are returned unchanged.
+func (d LocalDate) EndOfYear() LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return LocalDate{Days: int32(daysFromCivil(int64(d.Year()), time.December, 31)), Valid: true}
+}
+
+// IsLeapYear reports whether d is in a leap year. It returns false when d is
+// not finite.
+func (d LocalDate) IsLeapYear() bool {
+	return d.IsFinite() && isLeapYear(int64(d.Year()))
+}
+
+// DaysInMonth returns the number of days in the month of d, or 0 when d is not
+// finite.
+func (d LocalDate) DaysInMonth() int {
+	return d.YearMonth().Days()
+}
+
+// DaysInYear returns 365 or 366 depending on the year of d, or 0 when d is not
+// finite.
+func (d LocalDate) DaysInYear() int {
+	switch {
+	case !d.IsFinite():
+		return 0
+	case d.IsLeapYear():
+		return 366
+	default:
+		return 365
+	}
+}
+
+// IsLastDayOfMonth reports whether d is the last day of its month. It returns
+// false when d is not finite.
+func (d LocalDate) IsLastDayOfMonth() bool {
+	return d.IsFinite() && d.Day() == d.DaysInMonth()
+}
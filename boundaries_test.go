@@ -0,0 +1,79 @@
+package localdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBoundaries(t *testing.T) {
+	d := NewLocalDate(2024, time.May, 15) // a Wednesday
+
+	tests := []struct {
+		name string
+		got  LocalDate
+		want LocalDate
+	}{
+		{name: "end of ISO week", got: d.EndOfWeek(time.Monday), want: NewLocalDate(2024, time.May, 19)},
+		{name: "end of US week", got: d.EndOfWeek(time.Sunday), want: NewLocalDate(2024, time.May, 18)},
+		{name: "end of week on last day", got: NewLocalDate(2024, time.May, 19).EndOfWeek(time.Monday), want: NewLocalDate(2024, time.May, 19)},
+		{name: "start of month", got: d.StartOfMonth(), want: NewLocalDate(2024, time.May, 1)},
+		{name: "end of month", got: d.EndOfMonth(), want: NewLocalDate(2024, time.May, 31)},
+		{name: "end of february", got: NewLocalDate(2024, time.February, 10).EndOfMonth(), want: NewLocalDate(2024, time.February, 29)},
+		{name: "start of quarter", got: d.StartOfQuarter(), want: NewLocalDate(2024, time.April, 1)},
+		{name: "end of quarter", got: d.EndOfQuarter(), want: NewLocalDate(2024, time.June, 30)},
+		{name: "start of half-year", got: NewLocalDate(2024, time.October, 3).StartOfHalfYear(), want: NewLocalDate(2024, time.July, 1)},
+		{name: "end of half-year", got: d.EndOfHalfYear(), want: NewLocalDate(2024, time.June, 30)},
+		{name: "start of year", got: d.StartOfYear(), want: NewLocalDate(2024, time.January, 1)},
+		{name: "end of year", got: d.EndOfYear(), want: NewLocalDate(2024, time.December, 31)},
+		{name: "start of year before epoch", got: NewLocalDate(1900, time.March, 1).StartOfYear(), want: NewLocalDate(1900, time.January, 1)},
+		{name: "infinity end of month", got: InfinityDate().EndOfMonth(), want: InfinityDate()},
+		{name: "negative infinity start of year", got: NegInfinityDate().StartOfYear(), want: NegInfinityDate()},
+		{name: "invalid end of quarter", got: LocalDate{}.EndOfQuarter(), want: LocalDate{}},
+		{name: "infinity end of week", got: InfinityDate().EndOfWeek(time.Monday), want: InfinityDate()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarPredicates(t *testing.T) {
+	tests := []struct {
+		date            LocalDate
+		wantLeap        bool
+		wantMonthDays   int
+		wantYearDays    int
+		wantLastOfMonth bool
+	}{
+		{NewLocalDate(2024, time.February, 29), true, 29, 366, true},
+		{NewLocalDate(2023, time.February, 28), false, 28, 365, true},
+		{NewLocalDate(1900, time.February, 28), false, 28, 365, true},
+		{NewLocalDate(2000, time.February, 28), true, 29, 366, false},
+		{NewLocalDate(2024, time.April, 30), true, 30, 366, true},
+		{NewLocalDate(2024, time.December, 30), true, 31, 366, false},
+		{InfinityDate(), false, 0, 0, false},
+		{NegInfinityDate(), false, 0, 0, false},
+		{LocalDate{}, false, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			if got := tt.date.IsLeapYear(); got != tt.wantLeap {
+				t.Errorf("IsLeapYear() = %v, want %v", got, tt.wantLeap)
+			}
+			if got := tt.date.DaysInMonth(); got != tt.wantMonthDays {
+				t.Errorf("DaysInMonth() = %v, want %v", got, tt.wantMonthDays)
+			}
+			if got := tt.date.DaysInYear(); got != tt.wantYearDays {
+				t.Errorf("DaysInYear() = %v, want %v", got, tt.wantYearDays)
+			}
+			if got := tt.date.IsLastDayOfMonth(); got != tt.wantLastOfMonth {
+				t.Errorf("IsLastDayOfMonth() = %v, want %v", got, tt.wantLastOfMonth)
+			}
+		})
+	}
+}
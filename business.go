@@ -0,0 +1,186 @@
+package localdate
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"time"
+)
+
+// ErrNoBusinessDays is returned when a Weekend covers every day of the week.
+var ErrNoBusinessDays = errors.New("localdate: weekend covers every day of the week")
+
+// Weekend is a set of weekdays that are not business days, with bit 1<<wd set
+// for every time.Weekday wd in the set.
+type Weekend uint8
+
+const (
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday
+)
+
+// NewWeekend returns the weekend made up of days.
+func NewWeekend(days ...time.Weekday) Weekend {
+	var w Weekend
+	for _, wd := range days {
+		w |= 1 << wd
+	}
+	return w
+}
+
+// Contains reports whether wd is a weekend day.
+func (w Weekend) Contains(wd time.Weekday) bool {
+	return w&(1<<wd) != 0
+}
+
+// workdays returns the number of business days in a week without holidays.
+func (w Weekend) workdays() int {
+	return 7 - bits.OnesCount8(uint8(w)&0x7f)
+}
+
+// BusinessCalendar does business day arithmetic, where business days are the
+// days that are neither weekend days nor holidays. The zero value has no
+// weekend and no holidays, so set Weekend, e.g. to SaturdaySunday.
+type BusinessCalendar struct {
+	Weekend Weekend
+	// Holidays are the holidays that are not business days. It may be nil.
+	Holidays HolidayCalendar
+}
+
+// IsBusinessDay reports whether d is a business day. Infinite and invalid
+// dates are not.
+func (c BusinessCalendar) IsBusinessDay(d LocalDate) bool {
+	return d.IsFinite() && !c.Weekend.Contains(d.Weekday()) && (c.Holidays == nil || !c.Holidays.IsHoliday(d))
+}
+
+// NextBusinessDay returns the first business day strictly after d. Infinite
+// and invalid dates are returned unchanged, as is d if the weekend covers
+// every day.
+func (c BusinessCalendar) NextBusinessDay(d LocalDate) LocalDate {
+	return c.step(d, 1)
+}
+
+// PrevBusinessDay returns the last business day strictly before d. Infinite
+// and invalid dates are returned unchanged, as is d if the weekend covers
+// every day.
+func (c BusinessCalendar) PrevBusinessDay(d LocalDate) LocalDate {
+	return c.step(d, -1)
+}
+
+func (c BusinessCalendar) step(d LocalDate, dir int) LocalDate {
+	if !d.IsFinite() || c.Weekend.workdays() == 0 {
+		return d
+	}
+	d = AddDays(d, dir)
+	for !c.IsBusinessDay(d) {
+		d = AddDays(d, dir)
+	}
+	return d
+}
+
+// NthBusinessWeekdayOfMonth returns the n:th wd of the month that is a
+// business day, skipping occurrences that are holidays. Negative n counts from
+// the end of the month, so n = -1 is the last business wd, e.g. the last
+// business Friday. It returns ErrNonexistentDate if the month has no such day
+// and an error if n is zero.
+func (c BusinessCalendar) NthBusinessWeekdayOfMonth(year int, month time.Month, wd time.Weekday, n int) (LocalDate, error) {
+	ym := NewYearMonth(year, month)
+	d, step, remaining := ym.FirstDay().OnOrAfter(wd), 7, n
+	switch {
+	case n < 0:
+		d, step, remaining = ym.LastDay().OnOrBefore(wd), -7, -n
+	case n == 0:
+		return LocalDate{}, fmt.Errorf("localdate: weekday occurrence must not be zero")
+	}
+	// step whole weeks so that holidays are skipped without changing weekday
+	for ; ym.Contains(d); d = AddDays(d, step) {
+		if c.IsBusinessDay(d) {
+			remaining--
+			if remaining == 0 {
+				return d, nil
+			}
+		}
+	}
+	return LocalDate{}, fmt.Errorf("%w: no business %v number %d in %v", ErrNonexistentDate, wd, n, ym)
+}
+
+// AddBusinessDays returns the date n business days after d, or before d if n
+// is negative. d itself need not be a business day, so one business day after
+// a Saturday is the following Monday. It returns d for n = 0, ErrInfiniteDate
+// or ErrInvalidDate if d is not finite and ErrNoBusinessDays if the weekend
+// covers every day.
+func (c BusinessCalendar) AddBusinessDays(d LocalDate, n int) (LocalDate, error) {
+	if err := checkFinite(d); err != nil {
+		return LocalDate{}, err
+	}
+	perWeek := c.Weekend.workdays()
+	if perWeek == 0 {
+		return LocalDate{}, ErrNoBusinessDays
+	}
+	dir := 1
+	if n < 0 {
+		dir, n = -1, -n
+	}
+	for n > 0 {
+		// jump whole weeks while they cannot overshoot, then step day by day
+		if weeks := (n - 1) / perWeek; weeks > 0 {
+			next := AddDays(d, dir*weeks*7)
+			if dir > 0 {
+				n -= c.countBetween(d, next)
+			} else {
+				// stepping back passes [next, d), which is (next-1, d-1]
+				n -= c.countBetween(AddDays(next, -1), AddDays(d, -1))
+			}
+			d = next
+			continue
+		}
+		d = c.step(d, dir)
+		n--
+	}
+	return d, nil
+}
+
+// BusinessDaysBetween returns the number of business days in (a, b], i.e.
+// after a up to and including b, or the negated number of business days in
+// (b, a] if b is before a. It returns ErrInfiniteDate or ErrInvalidDate if a
+// or b is not finite.
+//
+// The count takes time proportional to the number of years spanned rather
+// than the number of days.
+func (c BusinessCalendar) BusinessDaysBetween(a, b LocalDate) (int, error) {
+	if err := checkFinite(a, b); err != nil {
+		return 0, err
+	}
+	if IsBefore(b, a) {
+		return -c.countBetween(b, a), nil
+	}
+	return c.countBetween(a, b), nil
+}
+
+// countBetween returns the number of business days in (a, b] for finite a
+// before or equal to b.
+func (c BusinessCalendar) countBetween(a, b LocalDate) int {
+	days := int(b.Days - a.Days)
+	full := days / 7
+	count := full * c.Weekend.workdays()
+	for i := full*7 + 1; i <= days; i++ {
+		if !c.Weekend.Contains(weekdayOf(int64(a.Days) + int64(i))) {
+			count++
+		}
+	}
+	if c.Holidays == nil {
+		return count
+	}
+
+	for year := a.Year(); year <= b.Year(); year++ {
+		var prev LocalDate
+		for _, h := range c.Holidays.Holidays(year) {
+			// holidays are sorted, count each date once
+			if h.Date != prev && IsAfter(h.Date, a) && !IsAfter(h.Date, b) && !c.Weekend.Contains(h.Date.Weekday()) {
+				count--
+			}
+			prev = h.Date
+		}
+	}
+	return count
+}
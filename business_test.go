@@ -0,0 +1,225 @@
+package localdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWeekend(t *testing.T) {
+	w := NewWeekend(time.Friday, time.Saturday)
+	if w != FridaySaturday {
+		t.Errorf("NewWeekend() = %b, want %b", w, FridaySaturday)
+	}
+	if !w.Contains(time.Friday) || w.Contains(time.Sunday) {
+		t.Errorf("Contains() mismatch for %b", w)
+	}
+	if got := SaturdaySunday.workdays(); got != 5 {
+		t.Errorf("workdays() = %v, want 5", got)
+	}
+}
+
+func TestBusinessCalendar(t *testing.T) {
+	se := BusinessCalendar{Weekend: SaturdaySunday, Holidays: SwedishCalendar(true)}
+
+	tests := []struct {
+		name string
+		got  LocalDate
+		want LocalDate
+	}{
+		{name: "next over weekend", got: se.NextBusinessDay(NewLocalDate(2024, time.May, 3)), want: NewLocalDate(2024, time.May, 6)},
+		{name: "next over midsummer", got: se.NextBusinessDay(NewLocalDate(2024, time.June, 20)), want: NewLocalDate(2024, time.June, 24)},
+		{name: "prev over easter", got: se.PrevBusinessDay(NewLocalDate(2024, time.April, 2)), want: NewLocalDate(2024, time.March, 28)},
+		{name: "next of infinity", got: se.NextBusinessDay(InfinityDate()), want: InfinityDate()},
+		{name: "prev of invalid", got: se.PrevBusinessDay(LocalDate{}), want: LocalDate{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if se.IsBusinessDay(NewLocalDate(2024, time.December, 24)) {
+		t.Errorf("Expected Julafton not to be a business day")
+	}
+	if !se.IsBusinessDay(NewLocalDate(2024, time.December, 23)) {
+		t.Errorf("Expected 2024-12-23 to be a business day")
+	}
+	if se.IsBusinessDay(InfinityDate()) {
+		t.Errorf("Expected infinity not to be a business day")
+	}
+
+	gulf := BusinessCalendar{Weekend: FridaySaturday}
+	if gulf.IsBusinessDay(NewLocalDate(2024, time.May, 3)) || !gulf.IsBusinessDay(NewLocalDate(2024, time.May, 5)) {
+		t.Errorf("Expected Friday off and Sunday on with a Friday-Saturday weekend")
+	}
+}
+
+func TestNthBusinessWeekdayOfMonth(t *testing.T) {
+	se := BusinessCalendar{Weekend: SaturdaySunday, Holidays: SwedishCalendar(true)}
+
+	tests := []struct {
+		name    string
+		month   time.Month
+		wd      time.Weekday
+		n       int
+		want    LocalDate
+		wantErr error
+	}{
+		{name: "last Friday is Good Friday", month: time.March, wd: time.Friday, n: -1, want: NewLocalDate(2024, time.March, 22)},
+		{name: "second to last Friday", month: time.March, wd: time.Friday, n: -2, want: NewLocalDate(2024, time.March, 15)},
+		{name: "first Monday is Easter Monday", month: time.April, wd: time.Monday, n: 1, want: NewLocalDate(2024, time.April, 8)},
+		{name: "no holiday", month: time.May, wd: time.Tuesday, n: 2, want: NewLocalDate(2024, time.May, 14)},
+		{name: "last Tuesday of December", month: time.December, wd: time.Tuesday, n: -1, want: NewLocalDate(2024, time.December, 17)},
+		{name: "too few", month: time.March, wd: time.Friday, n: 5, wantErr: ErrNonexistentDate},
+		{name: "weekend day", month: time.March, wd: time.Saturday, n: 1, wantErr: ErrNonexistentDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := se.NthBusinessWeekdayOfMonth(2024, tt.month, tt.wd, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NthBusinessWeekdayOfMonth() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NthBusinessWeekdayOfMonth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := se.NthBusinessWeekdayOfMonth(2024, time.March, time.Friday, 0); err == nil {
+		t.Errorf("Expected error for n = 0")
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	se := BusinessCalendar{Weekend: SaturdaySunday, Holidays: SwedishCalendar(true)}
+
+	tests := []struct {
+		name string
+		cal  BusinessCalendar
+		date LocalDate
+		n    int
+		want LocalDate
+	}{
+		{name: "zero", cal: se, date: NewLocalDate(2024, time.May, 4), n: 0, want: NewLocalDate(2024, time.May, 4)},
+		{name: "from saturday", cal: se, date: NewLocalDate(2024, time.May, 4), n: 1, want: NewLocalDate(2024, time.May, 6)},
+		{name: "over christmas", cal: se, date: NewLocalDate(2024, time.December, 20), n: 3, want: NewLocalDate(2024, time.December, 30)},
+		{name: "backward over christmas", cal: se, date: NewLocalDate(2024, time.December, 30), n: -3, want: NewLocalDate(2024, time.December, 20)},
+		{name: "official holidays only", cal: BusinessCalendar{Weekend: SaturdaySunday, Holidays: SwedishCalendar(false)}, date: NewLocalDate(2024, time.December, 23), n: 1, want: NewLocalDate(2024, time.December, 24)},
+		{name: "no holidays", cal: BusinessCalendar{Weekend: SaturdaySunday}, date: NewLocalDate(2024, time.January, 1), n: 260, want: NewLocalDate(2024, time.December, 30)},
+		{name: "friday saturday weekend", cal: BusinessCalendar{Weekend: FridaySaturday}, date: NewLocalDate(2024, time.May, 2), n: 1, want: NewLocalDate(2024, time.May, 5)},
+		{name: "no weekend", cal: BusinessCalendar{}, date: NewLocalDate(2024, time.May, 2), n: 10, want: NewLocalDate(2024, time.May, 12)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cal.AddBusinessDays(tt.date, tt.n)
+			if err != nil {
+				t.Fatalf("AddBusinessDays() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("AddBusinessDays(%v, %d) = %v, want %v", tt.date, tt.n, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := se.AddBusinessDays(InfinityDate(), 1); !errors.Is(err, ErrInfiniteDate) {
+		t.Errorf("AddBusinessDays(infinity) error = %v, want %v", err, ErrInfiniteDate)
+	}
+	allWeekend := BusinessCalendar{Weekend: NewWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)}
+	if _, err := allWeekend.AddBusinessDays(NewLocalDate(2024, time.May, 2), 1); !errors.Is(err, ErrNoBusinessDays) {
+		t.Errorf("AddBusinessDays() error = %v, want %v", err, ErrNoBusinessDays)
+	}
+	if got := allWeekend.NextBusinessDay(NewLocalDate(2024, time.May, 2)); got != NewLocalDate(2024, time.May, 2) {
+		t.Errorf("NextBusinessDay() = %v, want unchanged", got)
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	se := BusinessCalendar{Weekend: SaturdaySunday, Holidays: SwedishCalendar(false)}
+
+	tests := []struct {
+		name string
+		a    LocalDate
+		b    LocalDate
+		want int
+	}{
+		{name: "same day", a: NewLocalDate(2024, time.May, 6), b: NewLocalDate(2024, time.May, 6), want: 0},
+		{name: "excludes start", a: NewLocalDate(2024, time.May, 6), b: NewLocalDate(2024, time.May, 7), want: 1},
+		{name: "over weekend", a: NewLocalDate(2024, time.May, 3), b: NewLocalDate(2024, time.May, 6), want: 1},
+		{name: "reversed", a: NewLocalDate(2024, time.May, 6), b: NewLocalDate(2024, time.May, 3), want: -1},
+		{name: "whole year 2024", a: NewLocalDate(2023, time.December, 31), b: NewLocalDate(2024, time.December, 31), want: 254},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := se.BusinessDaysBetween(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("BusinessDaysBetween() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("BusinessDaysBetween(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := se.BusinessDaysBetween(LocalDate{}, NewLocalDate(2024, time.May, 6)); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("BusinessDaysBetween(invalid) error = %v, want %v", err, ErrInvalidDate)
+	}
+}
+
+// TestBusinessDaysAgainstLoop compares the week-based counting with counting
+// day by day.
+func TestBusinessDaysAgainstLoop(t *testing.T) {
+	gb, err := UKCalendar(EnglandAndWales)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calendars := map[string]BusinessCalendar{
+		"sweden":          {Weekend: SaturdaySunday, Holidays: SwedishCalendar(true)},
+		"england":         {Weekend: SaturdaySunday, Holidays: gb},
+		"friday saturday": {Weekend: FridaySaturday, Holidays: SwedishCalendar(false)},
+		"sunday only":     {Weekend: NewWeekend(time.Sunday)},
+	}
+
+	start := NewLocalDate(2019, time.December, 27)
+	for name, cal := range calendars {
+		t.Run(name, func(t *testing.T) {
+			want := 0
+			for i := 1; i <= 3*366; i++ {
+				b := AddDays(start, i)
+				if cal.IsBusinessDay(b) {
+					want++
+				}
+				if i%37 != 0 {
+					continue
+				}
+				got, err := cal.BusinessDaysBetween(start, b)
+				if err != nil || got != want {
+					t.Fatalf("BusinessDaysBetween(%v, %v) = %v, %v, want %v", start, b, got, err, want)
+				}
+				if want == 0 {
+					continue
+				}
+				added, err := cal.AddBusinessDays(start, want)
+				if err != nil || IsAfter(added, b) || !cal.IsBusinessDay(added) {
+					t.Fatalf("AddBusinessDays(%v, %d) = %v, %v", start, want, added, err)
+				}
+				if n, _ := cal.BusinessDaysBetween(start, added); n != want {
+					t.Fatalf("AddBusinessDays(%v, %d) = %v, which is %d business days away", start, want, added, n)
+				}
+				wantBack := start
+				if !cal.IsBusinessDay(start) {
+					wantBack = cal.PrevBusinessDay(start)
+				}
+				if back, err := cal.AddBusinessDays(added, -want); err != nil || back != wantBack {
+					t.Fatalf("AddBusinessDays(%v, %d) = %v, %v, want %v", added, -want, back, err, wantBack)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package localdate
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// calendarUnit is a calendar period such as YearMonth, YearWeek, Quarter or
+// HalfYear. The periods of a type are numbered consecutively by index and the
+// zero value is NULL. The helpers below implement the methods the types have
+// in common, so each type only supplies its arithmetic, format and parsing.
+type calendarUnit interface {
+	comparable
+	index() int64
+	FirstDay() LocalDate
+	LastDay() LocalDate
+	AppendText(b []byte) ([]byte, error)
+}
+
+// compareUnits implements Compare, sorting the zero value before all others.
+func compareUnits[T calendarUnit](a, b T) int {
+	var zero T
+	switch {
+	case a == b:
+		return 0
+	case a == zero:
+		return -1
+	case b == zero:
+		return 1
+	default:
+		return cmp.Compare(a.index(), b.index())
+	}
+}
+
+// unitDates implements Dates, iterating over the days of u in ascending order.
+func unitDates[T calendarUnit](u T) iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		var zero T
+		if u == zero {
+			return
+		}
+		first, last := u.FirstDay(), u.LastDay()
+		for d := first; d.Days <= last.Days; d = AddDays(d, 1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// eachUnit implements the EachX functions, iterating from from to to, both
+// included, backward if to is before from.
+func eachUnit[T calendarUnit](from, to T, fromIndex func(int64) T) (iter.Seq[T], error) {
+	var zero T
+	if from == zero || to == zero {
+		return nil, ErrInvalidDate
+	}
+	step := int64(1)
+	if compareUnits(to, from) < 0 {
+		step = -1
+	}
+	first, last := from.index(), to.index()
+	return func(yield func(T) bool) {
+		for n := first; ; n += step {
+			if !yield(fromIndex(n)) || n == last {
+				return
+			}
+		}
+	}, nil
+}
+
+// appendPartOfYear appends year followed by a dash, the designator and n, e.g.
+// 2024-Q2.
+func appendPartOfYear(b []byte, year int, designator byte, n int) []byte {
+	b = appendYear(b, year)
+	b = append(b, '-', designator)
+	return appendInt(b, n, 1)
+}
+
+// parsePartOfYear parses text written by appendPartOfYear with n in [1, limit].
+func parsePartOfYear(s string, designator byte, limit int) (year, n int, ok bool) {
+	i := strings.LastIndexByte(s, '-')
+	if i <= 0 || len(s)-i != 3 || s[i+1] != designator {
+		return 0, 0, false
+	}
+	n = int(s[i+2]) - '0'
+	if n < 1 || n > limit {
+		return 0, 0, false
+	}
+	year, ok = parseYear(s[:i])
+	return year, n, ok
+}
+
+// unmarshalUnitText implements UnmarshalText, where empty text is the zero
+// value.
+func unmarshalUnitText[T calendarUnit](dst *T, text []byte, parse func(string) (T, error)) error {
+	if len(text) == 0 {
+		var zero T
+		*dst = zero
+		return nil
+	}
+	return parseInto(dst, string(text), parse)
+}
+
+// marshalUnitJSON implements MarshalJSON, encoding the zero value as null.
+func marshalUnitJSON[T calendarUnit](u T) ([]byte, error) {
+	var zero T
+	if u == zero {
+		return []byte("null"), nil
+	}
+	b := append(make([]byte, 0, 10), '"')
+	b, err := u.AppendText(b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '"'), nil
+}
+
+// unmarshalUnitJSON implements UnmarshalJSON, decoding null as the zero value.
+func unmarshalUnitJSON[T calendarUnit](dst *T, data []byte, parse func(string) (T, error)) error {
+	if string(data) == "null" {
+		var zero T
+		*dst = zero
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return parseInto(dst, s, parse)
+}
+
+// scanUnit implements Scan. It accepts the text form, or a date that is the
+// first day of a period of the type as text, time.Time or pgtype.Date. NULL
+// scans as the zero value.
+func scanUnit[T calendarUnit](dst *T, value interface{}, name string, parse func(string) (T, error), of func(LocalDate) T) error {
+	u, err := scanValue(value, name, parse, of)
+	if err != nil {
+		return err
+	}
+	*dst = u
+	return nil
+}
+
+func scanValue[T calendarUnit](value interface{}, name string, parse func(string) (T, error), of func(LocalDate) T) (T, error) {
+	var zero T
+	scanDate := func(d LocalDate) (T, error) {
+		u := of(d)
+		if !d.IsFinite() || d != u.FirstDay() {
+			return zero, fmt.Errorf("cannot scan %v into %s: not its first day", d, name)
+		}
+		return u, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		parsed, err := parse(v)
+		if err == nil {
+			return parsed, nil
+		}
+		d, dateErr := parseLocalDate(v)
+		if dateErr != nil {
+			return zero, err
+		}
+		return scanDate(d)
+	case []byte:
+		return scanValue(string(v), name, parse, of)
+	case time.Time:
+		return scanDate(ToLocalDate(v))
+	case pgtype.Date:
+		if !v.Valid {
+			return zero, nil
+		}
+		return scanDate(FromPgDate(v))
+	case nil:
+		return zero, nil
+	default:
+		return zero, fmt.Errorf("unsupported Scan, storing %T into %s", value, name)
+	}
+}
+
+// parseInto stores the result of parse in dst unless it fails.
+func parseInto[T any](dst *T, s string, parse func(string) (T, error)) error {
+	u, err := parse(s)
+	if err != nil {
+		return err
+	}
+	*dst = u
+	return nil
+}
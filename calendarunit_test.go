@@ -0,0 +1,48 @@
+package localdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCalendarUnitScanDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		scan    func(value interface{}) error
+		first   LocalDate
+		another LocalDate
+	}{
+		{name: "YearMonth", scan: new(YearMonth).Scan, first: NewLocalDate(2024, time.May, 1), another: NewLocalDate(2024, time.May, 2)},
+		{name: "Quarter", scan: new(Quarter).Scan, first: NewLocalDate(2024, time.April, 1), another: NewLocalDate(2024, time.May, 1)},
+		{name: "HalfYear", scan: new(HalfYear).Scan, first: NewLocalDate(2024, time.July, 1), another: NewLocalDate(2024, time.April, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, value := range []interface{}{tt.first.String(), []byte(tt.first.String()), tt.first.Time(), tt.first.PgDate()} {
+				if err := tt.scan(value); err != nil {
+					t.Errorf("Scan(%v) error = %v", value, err)
+				}
+			}
+			for _, value := range []interface{}{tt.another.String(), tt.another.Time(), InfinityDate().String(), 42} {
+				if err := tt.scan(value); err == nil {
+					t.Errorf("Scan(%v) expected error", value)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarUnitJSONNull(t *testing.T) {
+	values := []interface{}{&YearMonth{Year: 2024, Month: time.May}, &YearWeek{Year: 2024, Week: 5}, &Quarter{Year: 2024, Quarter: 2}, &HalfYear{Year: 2024, Half: 1}}
+	for _, v := range values {
+		if err := json.Unmarshal([]byte("null"), v); err != nil {
+			t.Fatalf("Unmarshal(null) into %T error = %v", v, err)
+		}
+		data, err := json.Marshal(v)
+		if err != nil || string(data) != "null" {
+			t.Errorf("Marshal(%T) = %s, %v, want null", v, data, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package localdate
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Compare compares d and other and returns -1 if d is before other, 0 if they
+// are equal and +1 if d is after other.
+//
+// Dates are totally ordered as
+//
+//	invalid < -infinity < finite dates < infinity
+//
+// where all invalid dates are equal to each other regardless of Days.
+func (d LocalDate) Compare(other LocalDate) int {
+	if !d.Valid || !other.Valid {
+		return cmp.Compare(validRank(d), validRank(other))
+	}
+	// daysNegInfinity and daysInfinity are the extremes of int32, so
+	// comparing Days alone gives the documented order for valid dates
+	return cmp.Compare(d.Days, other.Days)
+}
+
+func validRank(d LocalDate) int {
+	if d.Valid {
+		return 1
+	}
+	return 0
+}
+
+// Compare is the function form of LocalDate.Compare, suitable for
+// slices.SortFunc, slices.BinarySearchFunc and similar APIs.
+func Compare(a, b LocalDate) int {
+	return a.Compare(b)
+}
+
+// Min returns the earliest of the given dates according to Compare.
+func Min(first LocalDate, rest ...LocalDate) LocalDate {
+	m := first
+	for _, d := range rest {
+		if d.Compare(m) < 0 {
+			m = d
+		}
+	}
+	return m
+}
+
+// Max returns the latest of the given dates according to Compare.
+func Max(first LocalDate, rest ...LocalDate) LocalDate {
+	m := first
+	for _, d := range rest {
+		if d.Compare(m) > 0 {
+			m = d
+		}
+	}
+	return m
+}
+
+// Clamp returns d limited to the closed interval [lo, hi]. If lo is after hi
+// the result is lo.
+func Clamp(d, lo, hi LocalDate) LocalDate {
+	if d.Compare(hi) > 0 {
+		d = hi
+	}
+	if d.Compare(lo) < 0 {
+		d = lo
+	}
+	return d
+}
+
+// Sort sorts dates in ascending order according to Compare.
+func Sort(dates []LocalDate) {
+	slices.SortFunc(dates, Compare)
+}
+
+// IsSorted reports whether dates are sorted in ascending order according to
+// Compare.
+func IsSorted(dates []LocalDate) bool {
+	return slices.IsSortedFunc(dates, Compare)
+}
+
+// BinarySearch searches for target in the sorted slice dates and returns the
+// position where target is found, or where it would be inserted, and whether
+// it was found.
+func BinarySearch(dates []LocalDate, target LocalDate) (int, bool) {
+	return slices.BinarySearchFunc(dates, target, Compare)
+}
@@ -0,0 +1,212 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a    LocalDate
+		b    LocalDate
+		want int
+	}{
+		{
+			name: "equal dates",
+			a:    NewLocalDate(2023, time.May, 15),
+			b:    NewLocalDate(2023, time.May, 15),
+			want: 0,
+		},
+		{
+			name: "a before b",
+			a:    NewLocalDate(2023, time.May, 14),
+			b:    NewLocalDate(2023, time.May, 15),
+			want: -1,
+		},
+		{
+			name: "a after b",
+			a:    NewLocalDate(2023, time.May, 16),
+			b:    NewLocalDate(2023, time.May, 15),
+			want: 1,
+		},
+		{
+			name: "invalid before epoch",
+			a:    LocalDate{},
+			b:    NewLocalDate(1970, time.January, 1),
+			want: -1,
+		},
+		{
+			name: "invalid before negative infinity",
+			a:    LocalDate{},
+			b:    NegInfinityDate(),
+			want: -1,
+		},
+		{
+			name: "invalid dates are equal regardless of days",
+			a:    LocalDate{Days: 1},
+			b:    LocalDate{Days: 2},
+			want: 0,
+		},
+		{
+			name: "negative infinity before min date",
+			a:    NegInfinityDate(),
+			b:    NewLocalDate(1, time.January, 1),
+			want: -1,
+		},
+		{
+			name: "infinity after max date",
+			a:    InfinityDate(),
+			b:    NewLocalDate(9999, time.December, 31),
+			want: 1,
+		},
+		{
+			name: "infinity equals infinity",
+			a:    InfinityDate(),
+			b:    InfinityDate(),
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.want {
+				t.Errorf("Compare(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := tt.b.Compare(tt.a); got != -tt.want {
+				t.Errorf("Compare(%v, %v) = %v, want %v", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestIsEqualInvalid(t *testing.T) {
+	if IsEqual(LocalDate{}, NewLocalDate(1970, time.January, 1)) {
+		t.Errorf("Expected invalid date not to equal 1970-01-01")
+	}
+	if !IsBefore(LocalDate{}, NewLocalDate(1970, time.January, 1)) {
+		t.Errorf("Expected invalid date to be before 1970-01-01")
+	}
+	if IsAfter(LocalDate{}, NegInfinityDate()) {
+		t.Errorf("Expected invalid date not to be after negative infinity")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	a := NewLocalDate(2023, time.May, 10)
+	b := NewLocalDate(2023, time.May, 15)
+	c := NewLocalDate(2023, time.May, 20)
+
+	tests := []struct {
+		name    string
+		dates   []LocalDate
+		wantMin LocalDate
+		wantMax LocalDate
+	}{
+		{
+			name:    "single date",
+			dates:   []LocalDate{b},
+			wantMin: b,
+			wantMax: b,
+		},
+		{
+			name:    "unordered dates",
+			dates:   []LocalDate{b, c, a},
+			wantMin: a,
+			wantMax: c,
+		},
+		{
+			name:    "with infinities",
+			dates:   []LocalDate{b, InfinityDate(), NegInfinityDate()},
+			wantMin: NegInfinityDate(),
+			wantMax: InfinityDate(),
+		},
+		{
+			name:    "with invalid",
+			dates:   []LocalDate{b, {}, c},
+			wantMin: LocalDate{},
+			wantMax: c,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Min(tt.dates[0], tt.dates[1:]...); !IsEqual(got, tt.wantMin) {
+				t.Errorf("Min(%v) = %v, want %v", tt.dates, got, tt.wantMin)
+			}
+			if got := Max(tt.dates[0], tt.dates[1:]...); !IsEqual(got, tt.wantMax) {
+				t.Errorf("Max(%v) = %v, want %v", tt.dates, got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestClamp(t *testing.T) {
+	lo := NewLocalDate(2023, time.May, 10)
+	hi := NewLocalDate(2023, time.May, 20)
+
+	tests := []struct {
+		name string
+		date LocalDate
+		want LocalDate
+	}{
+		{name: "inside", date: NewLocalDate(2023, time.May, 15), want: NewLocalDate(2023, time.May, 15)},
+		{name: "before", date: NewLocalDate(2023, time.May, 1), want: lo},
+		{name: "after", date: NewLocalDate(2023, time.June, 1), want: hi},
+		{name: "on lower bound", date: lo, want: lo},
+		{name: "on upper bound", date: hi, want: hi},
+		{name: "infinity", date: InfinityDate(), want: hi},
+		{name: "negative infinity", date: NegInfinityDate(), want: lo},
+		{name: "invalid", date: LocalDate{}, want: lo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Clamp(tt.date, lo, hi); !IsEqual(got, tt.want) {
+				t.Errorf("Clamp(%v, %v, %v) = %v, want %v", tt.date, lo, hi, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	dates := []LocalDate{
+		InfinityDate(),
+		NewLocalDate(2023, time.May, 20),
+		{},
+		NegInfinityDate(),
+		NewLocalDate(2023, time.May, 10),
+	}
+	want := []LocalDate{
+		{},
+		NegInfinityDate(),
+		NewLocalDate(2023, time.May, 10),
+		NewLocalDate(2023, time.May, 20),
+		InfinityDate(),
+	}
+
+	if IsSorted(dates) {
+		t.Fatalf("IsSorted(%v) = true, want false", dates)
+	}
+	Sort(dates)
+	if !slices.Equal(dates, want) {
+		t.Fatalf("Sort() = %v, want %v", dates, want)
+	}
+	if !IsSorted(dates) {
+		t.Errorf("IsSorted(%v) = false, want true", dates)
+	}
+
+	if i, found := BinarySearch(dates, NewLocalDate(2023, time.May, 20)); !found || i != 3 {
+		t.Errorf("BinarySearch() = %v, %v, want 3, true", i, found)
+	}
+	if i, found := BinarySearch(dates, NewLocalDate(2023, time.May, 15)); found || i != 3 {
+		t.Errorf("BinarySearch() = %v, %v, want 3, false", i, found)
+	}
+
+	slices.SortFunc(dates, func(a, b LocalDate) int { return Compare(b, a) })
+	slices.Reverse(want)
+	if !slices.Equal(dates, want) {
+		t.Errorf("slices.SortFunc() = %v, want %v", dates, want)
+	}
+}
@@ -0,0 +1,447 @@
+package localdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrRangeNotContiguous = errors.New("localdate: result of range union would not be contiguous")
+
+// DateRange is a range of dates modeled on the PostgreSQL daterange type.
+//
+// A Lower bound that is NegInfinityDate() or not Valid and an Upper bound that
+// is InfinityDate() or not Valid are unbounded, in which case the inclusive
+// flag is ignored. The opposite infinities, a Lower of InfinityDate() or an
+// Upper of NegInfinityDate(), make the range empty. The zero value is an
+// invalid (NULL) range. Like PostgreSQL, operations treat the range in its
+// canonical form [first, last+1), so [2024-01-01,2024-01-31] and
+// [2024-01-01,2024-02-01) are equal.
+type DateRange struct {
+	Lower          LocalDate
+	Upper          LocalDate
+	LowerInclusive bool
+	UpperInclusive bool
+	Valid          bool
+}
+
+// NewDateRange returns the range [lower, upper), which is the default bounds
+// of PostgreSQL's daterange constructor.
+func NewDateRange(lower, upper LocalDate) DateRange {
+	return DateRange{Lower: lower, Upper: upper, LowerInclusive: true, Valid: true}
+}
+
+// NewClosedDateRange returns the range [first, last].
+func NewClosedDateRange(first, last LocalDate) DateRange {
+	return DateRange{Lower: first, Upper: last, LowerInclusive: true, UpperInclusive: true, Valid: true}
+}
+
+// EmptyDateRange returns a range that contains no dates.
+func EmptyDateRange() DateRange {
+	return rangeFromSpan(span{})
+}
+
+// span is the canonical half-open form [lo, hi) of a DateRange in epoch days,
+// with unbounded ends at the int64 extremes.
+type span struct {
+	lo int64
+	hi int64
+}
+
+const (
+	spanNegInf = math.MinInt64
+	spanInf    = math.MaxInt64
+)
+
+func (s span) empty() bool {
+	return s.lo >= s.hi
+}
+
+func (r DateRange) span() span {
+	s := span{lo: spanNegInf, hi: spanInf}
+	switch {
+	case r.Lower.IsFinite():
+		s.lo = int64(r.Lower.Days)
+		if !r.LowerInclusive {
+			s.lo++
+		}
+	case r.Lower.Valid && r.Lower.IsInfinity():
+		s.lo = spanInf
+	}
+	switch {
+	case r.Upper.IsFinite():
+		s.hi = int64(r.Upper.Days)
+		if r.UpperInclusive {
+			s.hi++
+		}
+	case r.Upper.Valid && r.Upper.IsNegInfinity():
+		s.hi = spanNegInf
+	}
+	return s
+}
+
+func rangeFromSpan(s span) DateRange {
+	if s.empty() {
+		epoch := LocalDate{Valid: true}
+		return NewDateRange(epoch, epoch)
+	}
+	r := DateRange{Lower: NegInfinityDate(), Upper: InfinityDate(), Valid: true}
+	if s.lo != spanNegInf {
+		r.Lower = LocalDate{Days: int32(s.lo), Valid: true}
+		r.LowerInclusive = true
+	}
+	switch {
+	case s.hi == daysInfinity:
+		// the exclusive bound after the last finite date would be infinity,
+		// which means unbounded, so keep the last date as an inclusive bound
+		r.Upper = LocalDate{Days: daysInfinity - 1, Valid: true}
+		r.UpperInclusive = true
+	case s.hi != spanInf:
+		r.Upper = LocalDate{Days: int32(s.hi), Valid: true}
+	}
+	return r
+}
+
+// Canonical returns r in the canonical form used by PostgreSQL: an inclusive
+// lower bound and an exclusive upper bound, with unbounded ends set to
+// NegInfinityDate() and InfinityDate(). A range ending on the last finite date
+// keeps an inclusive upper bound, since the exclusive one would be infinity.
+func (r DateRange) Canonical() DateRange {
+	if !r.Valid {
+		return DateRange{}
+	}
+	return rangeFromSpan(r.span())
+}
+
+// IsEmpty reports whether r is a valid range that contains no dates.
+func (r DateRange) IsEmpty() bool {
+	return r.Valid && r.span().empty()
+}
+
+// IsBounded reports whether r is valid and has both a lower and an upper bound.
+// Empty ranges are bounded.
+func (r DateRange) IsBounded() bool {
+	s := r.span()
+	return r.Valid && (s.empty() || (s.lo != spanNegInf && s.hi != spanInf))
+}
+
+// Equal reports whether r and other contain exactly the same dates. Invalid
+// ranges are only equal to other invalid ranges.
+func (r DateRange) Equal(other DateRange) bool {
+	if !r.Valid || !other.Valid {
+		return r.Valid == other.Valid
+	}
+	a, b := r.span(), other.span()
+	if a.empty() || b.empty() {
+		return a.empty() == b.empty()
+	}
+	return a == b
+}
+
+// First returns the first date in r, NegInfinityDate() if r has no lower bound,
+// or an invalid date if r is empty or invalid.
+func (r DateRange) First() LocalDate {
+	s := r.span()
+	if !r.Valid || s.empty() {
+		return LocalDate{}
+	}
+	if s.lo == spanNegInf {
+		return NegInfinityDate()
+	}
+	return LocalDate{Days: int32(s.lo), Valid: true}
+}
+
+// Last returns the last date in r, InfinityDate() if r has no upper bound,
+// or an invalid date if r is empty or invalid.
+func (r DateRange) Last() LocalDate {
+	s := r.span()
+	if !r.Valid || s.empty() {
+		return LocalDate{}
+	}
+	if s.hi == spanInf {
+		return InfinityDate()
+	}
+	return LocalDate{Days: int32(s.hi - 1), Valid: true}
+}
+
+// Length returns the number of dates in r. It returns false if r is invalid or
+// unbounded.
+func (r DateRange) Length() (int, bool) {
+	if !r.IsBounded() {
+		return 0, false
+	}
+	s := r.span()
+	if s.empty() {
+		return 0, true
+	}
+	return int(s.hi - s.lo), true
+}
+
+// Contains reports whether d is within r. Infinite dates are contained in
+// ranges that are unbounded in the same direction.
+func (r DateRange) Contains(d LocalDate) bool {
+	if !r.Valid || !d.Valid {
+		return false
+	}
+	s := r.span()
+	if s.empty() {
+		return false
+	}
+	switch {
+	case d.IsInfinity():
+		return s.hi == spanInf
+	case d.IsNegInfinity():
+		return s.lo == spanNegInf
+	default:
+		return s.lo <= int64(d.Days) && int64(d.Days) < s.hi
+	}
+}
+
+// ContainsRange reports whether every date in other is within r. Every valid
+// range contains the empty range.
+func (r DateRange) ContainsRange(other DateRange) bool {
+	if !r.Valid || !other.Valid {
+		return false
+	}
+	a, b := r.span(), other.span()
+	if b.empty() {
+		return true
+	}
+	return a.lo <= b.lo && b.hi <= a.hi
+}
+
+// Overlaps reports whether r and other have any date in common.
+func (r DateRange) Overlaps(other DateRange) bool {
+	if !r.Valid || !other.Valid {
+		return false
+	}
+	return !r.span().intersect(other.span()).empty()
+}
+
+// IsAdjacent reports whether r and other do not overlap but together form a
+// contiguous range, e.g. [2024-01-01,2024-02-01) and [2024-02-01,2024-03-01).
+func (r DateRange) IsAdjacent(other DateRange) bool {
+	if !r.Valid || !other.Valid {
+		return false
+	}
+	a, b := r.span(), other.span()
+	if a.empty() || b.empty() {
+		return false
+	}
+	return a.hi == b.lo || b.hi == a.lo
+}
+
+// Intersect returns the dates that are in both r and other, which may be
+// empty. The result is invalid if either range is invalid.
+func (r DateRange) Intersect(other DateRange) DateRange {
+	if !r.Valid || !other.Valid {
+		return DateRange{}
+	}
+	return rangeFromSpan(r.span().intersect(other.span()))
+}
+
+// Union returns the dates that are in r or other. It returns
+// ErrRangeNotContiguous if the ranges neither overlap nor are adjacent, since
+// the result could not be represented as a single range.
+func (r DateRange) Union(other DateRange) (DateRange, error) {
+	if !r.Valid || !other.Valid {
+		return DateRange{}, nil
+	}
+	a, b := r.span(), other.span()
+	switch {
+	case a.empty():
+		return rangeFromSpan(b), nil
+	case b.empty():
+		return rangeFromSpan(a), nil
+	case a.intersect(b).empty() && a.hi != b.lo && b.hi != a.lo:
+		return DateRange{}, ErrRangeNotContiguous
+	}
+	return rangeFromSpan(span{lo: min(a.lo, b.lo), hi: max(a.hi, b.hi)}), nil
+}
+
+// Gap returns the dates strictly between r and other. The result is empty if
+// the ranges overlap, are adjacent or if either of them is empty.
+func (r DateRange) Gap(other DateRange) DateRange {
+	if !r.Valid || !other.Valid {
+		return DateRange{}
+	}
+	a, b := r.span(), other.span()
+	if a.empty() || b.empty() {
+		return EmptyDateRange()
+	}
+	if b.lo < a.lo {
+		a, b = b, a
+	}
+	return rangeFromSpan(span{lo: a.hi, hi: b.lo})
+}
+
+func (s span) intersect(o span) span {
+	return span{lo: max(s.lo, o.lo), hi: min(s.hi, o.hi)}
+}
+
+// String returns r in PostgreSQL's canonical text format, e.g.
+// [2024-01-01,2024-02-01), (,2024-02-01) or empty. An invalid range returns an
+// empty string.
+func (r DateRange) String() string {
+	if !r.Valid {
+		return ""
+	}
+	s := r.span()
+	if s.empty() {
+		return "empty"
+	}
+	var b strings.Builder
+	if s.lo == spanNegInf {
+		b.WriteString("(")
+	} else {
+		b.WriteString("[")
+		b.WriteString(LocalDate{Days: int32(s.lo), Valid: true}.String())
+	}
+	b.WriteString(",")
+	if s.hi == spanInf {
+		b.WriteString(")")
+	} else {
+		b.WriteString(LocalDate{Days: int32(s.hi), Valid: true}.String())
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// ParseDateRange parses a range in PostgreSQL's text format, e.g.
+// [2024-01-01,2024-01-31], (2023-12-31,2024-02-01), [2024-01-01,) or empty.
+// The bound values infinity and -infinity are read as unbounded.
+func ParseDateRange(s string) (DateRange, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "empty") {
+		return EmptyDateRange(), nil
+	}
+	if len(s) < 3 {
+		return DateRange{}, fmt.Errorf("invalid date range %q", s)
+	}
+
+	r := DateRange{Valid: true}
+	switch s[0] {
+	case '[':
+		r.LowerInclusive = true
+	case '(':
+	default:
+		return DateRange{}, fmt.Errorf("invalid date range %q: missing lower bound", s)
+	}
+	switch s[len(s)-1] {
+	case ']':
+		r.UpperInclusive = true
+	case ')':
+	default:
+		return DateRange{}, fmt.Errorf("invalid date range %q: missing upper bound", s)
+	}
+
+	lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return DateRange{}, fmt.Errorf("invalid date range %q: missing range separator", s)
+	}
+	var err error
+	if r.Lower, err = parseRangeBound(lower, NegInfinityDate()); err != nil {
+		return DateRange{}, fmt.Errorf("invalid date range %q: %w", s, err)
+	}
+	if r.Upper, err = parseRangeBound(upper, InfinityDate()); err != nil {
+		return DateRange{}, fmt.Errorf("invalid date range %q: %w", s, err)
+	}
+	return r, nil
+}
+
+func parseRangeBound(s string, unbounded LocalDate) (LocalDate, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	if s == "" {
+		return unbounded, nil
+	}
+	return parseLocalDate(s)
+}
+
+// SQL scanning
+func (r *DateRange) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		parsed, err := ParseDateRange(v)
+		if err != nil {
+			return err
+		}
+		*r = parsed
+		return nil
+	case []byte:
+		return r.Scan(string(v))
+	case pgtype.Range[pgtype.Date]:
+		*r = FromPgRange(v)
+		return nil
+	case nil:
+		*r = DateRange{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported Scan, storing %T into DateRange", value)
+	}
+}
+
+// SQL value
+func (r DateRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// pgtype conversion
+func (r DateRange) PgRange() pgtype.Range[pgtype.Date] {
+	if !r.Valid {
+		return pgtype.Range[pgtype.Date]{}
+	}
+	s := r.span()
+	if s.empty() {
+		return pgtype.Range[pgtype.Date]{
+			LowerType: pgtype.Empty,
+			UpperType: pgtype.Empty,
+			Valid:     true,
+		}
+	}
+	pr := pgtype.Range[pgtype.Date]{
+		LowerType: pgtype.Unbounded,
+		UpperType: pgtype.Unbounded,
+		Valid:     true,
+	}
+	if s.lo != spanNegInf {
+		pr.Lower = LocalDate{Days: int32(s.lo), Valid: true}.PgDate()
+		pr.LowerType = pgtype.Inclusive
+	}
+	if s.hi != spanInf {
+		pr.Upper = LocalDate{Days: int32(s.hi), Valid: true}.PgDate()
+		pr.UpperType = pgtype.Exclusive
+	}
+	return pr
+}
+
+// FromPgRange converts a pgtype daterange to a DateRange. Unbounded ends become
+// NegInfinityDate() and InfinityDate().
+func FromPgRange(pr pgtype.Range[pgtype.Date]) DateRange {
+	if !pr.Valid {
+		return DateRange{}
+	}
+	if pr.LowerType == pgtype.Empty || pr.UpperType == pgtype.Empty {
+		return EmptyDateRange()
+	}
+	r := DateRange{
+		Lower:          NegInfinityDate(),
+		Upper:          InfinityDate(),
+		LowerInclusive: pr.LowerType == pgtype.Inclusive,
+		UpperInclusive: pr.UpperType == pgtype.Inclusive,
+		Valid:          true,
+	}
+	if pr.LowerType != pgtype.Unbounded {
+		r.Lower = FromPgDate(pr.Lower)
+	}
+	if pr.UpperType != pgtype.Unbounded {
+		r.Upper = FromPgDate(pr.Upper)
+	}
+	return r
+}
@@ -0,0 +1,469 @@
+package localdate
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func mustParseDateRange(t *testing.T, s string) DateRange {
+	t.Helper()
+	r, err := ParseDateRange(s)
+	if err != nil {
+		t.Fatalf("ParseDateRange(%q) error = %v", s, err)
+	}
+	return r
+}
+
+func TestParseDateRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "half open", input: "[2024-01-01,2024-02-01)", want: "[2024-01-01,2024-02-01)"},
+		{name: "closed", input: "[2024-01-01,2024-01-31]", want: "[2024-01-01,2024-02-01)"},
+		{name: "open", input: "(2023-12-31,2024-02-01)", want: "[2024-01-01,2024-02-01)"},
+		{name: "unbounded lower", input: "(,2024-02-01)", want: "(,2024-02-01)"},
+		{name: "unbounded upper", input: "[2024-01-01,)", want: "[2024-01-01,)"},
+		{name: "unbounded", input: "(,)", want: "(,)"},
+		{name: "infinity bounds", input: "[-infinity,infinity]", want: "(,)"},
+		{name: "quoted values", input: `["2024-01-01","2024-02-01")`, want: "[2024-01-01,2024-02-01)"},
+		{name: "empty", input: "empty", want: "empty"},
+		{name: "empty by bounds", input: "[2024-01-01,2024-01-01)", want: "empty"},
+		{name: "reversed bounds", input: "[2024-02-01,2024-01-01)", want: "empty"},
+		{name: "missing lower bracket", input: "2024-01-01,2024-02-01)", wantErr: true},
+		{name: "missing upper bracket", input: "[2024-01-01,2024-02-01", wantErr: true},
+		{name: "missing separator", input: "[2024-01-01)", wantErr: true},
+		{name: "invalid date", input: "[2024-13-01,)", wantErr: true},
+		{name: "empty string", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDateRange(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDateRange(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseDateRange(%q) = %v, want %v", tt.input, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	r := NewDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.February, 1))
+
+	tests := []struct {
+		name string
+		r    DateRange
+		date LocalDate
+		want bool
+	}{
+		{name: "first day", r: r, date: NewLocalDate(2024, time.January, 1), want: true},
+		{name: "last day", r: r, date: NewLocalDate(2024, time.January, 31), want: true},
+		{name: "exclusive upper", r: r, date: NewLocalDate(2024, time.February, 1), want: false},
+		{name: "before", r: r, date: NewLocalDate(2023, time.December, 31), want: false},
+		{name: "infinity in bounded", r: r, date: InfinityDate(), want: false},
+		{name: "invalid date", r: r, date: LocalDate{}, want: false},
+		{name: "invalid range", r: DateRange{}, date: NewLocalDate(2024, time.January, 1), want: false},
+		{name: "empty range", r: EmptyDateRange(), date: NewLocalDate(1970, time.January, 1), want: false},
+		{name: "infinity in unbounded upper", r: mustParseDateRange(t, "[2024-01-01,)"), date: InfinityDate(), want: true},
+		{name: "negative infinity in unbounded upper", r: mustParseDateRange(t, "[2024-01-01,)"), date: NegInfinityDate(), want: false},
+		{name: "negative infinity in unbounded lower", r: mustParseDateRange(t, "(,2024-01-01)"), date: NegInfinityDate(), want: true},
+		{name: "far future in unbounded upper", r: mustParseDateRange(t, "[2024-01-01,)"), date: NewLocalDate(9999, time.December, 31), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Contains(tt.date); got != tt.want {
+				t.Errorf("%v.Contains(%v) = %v, want %v", tt.r, tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateRangeSetOperations(t *testing.T) {
+	tests := []struct {
+		name          string
+		a             string
+		b             string
+		wantOverlaps  bool
+		wantAdjacent  bool
+		wantContains  bool
+		wantIntersect string
+		wantUnion     string
+		wantGap       string
+	}{
+		{
+			name:          "overlapping",
+			a:             "[2024-01-01,2024-02-01)",
+			b:             "[2024-01-15,2024-03-01)",
+			wantOverlaps:  true,
+			wantIntersect: "[2024-01-15,2024-02-01)",
+			wantUnion:     "[2024-01-01,2024-03-01)",
+			wantGap:       "empty",
+		},
+		{
+			name:          "adjacent",
+			a:             "[2024-01-01,2024-01-31]",
+			b:             "[2024-02-01,2024-03-01)",
+			wantAdjacent:  true,
+			wantIntersect: "empty",
+			wantUnion:     "[2024-01-01,2024-03-01)",
+			wantGap:       "empty",
+		},
+		{
+			name:          "disjoint",
+			a:             "[2024-03-01,2024-04-01)",
+			b:             "[2024-01-01,2024-02-01)",
+			wantIntersect: "empty",
+			wantGap:       "[2024-02-01,2024-03-01)",
+		},
+		{
+			name:          "contained",
+			a:             "[2024-01-01,2024-12-31]",
+			b:             "[2024-05-01,2024-06-01)",
+			wantOverlaps:  true,
+			wantContains:  true,
+			wantIntersect: "[2024-05-01,2024-06-01)",
+			wantUnion:     "[2024-01-01,2025-01-01)",
+			wantGap:       "empty",
+		},
+		{
+			name:          "unbounded",
+			a:             "(,2024-02-01)",
+			b:             "[2024-01-01,)",
+			wantOverlaps:  true,
+			wantIntersect: "[2024-01-01,2024-02-01)",
+			wantUnion:     "(,)",
+			wantGap:       "empty",
+		},
+		{
+			name:          "with empty",
+			a:             "[2024-01-01,2024-02-01)",
+			b:             "empty",
+			wantContains:  true,
+			wantIntersect: "empty",
+			wantUnion:     "[2024-01-01,2024-02-01)",
+			wantGap:       "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParseDateRange(t, tt.a), mustParseDateRange(t, tt.b)
+			if got := a.Overlaps(b); got != tt.wantOverlaps {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.wantOverlaps)
+			}
+			if got := a.IsAdjacent(b); got != tt.wantAdjacent {
+				t.Errorf("IsAdjacent() = %v, want %v", got, tt.wantAdjacent)
+			}
+			if got := a.ContainsRange(b); got != tt.wantContains {
+				t.Errorf("ContainsRange() = %v, want %v", got, tt.wantContains)
+			}
+			if got := a.Intersect(b).String(); got != tt.wantIntersect {
+				t.Errorf("Intersect() = %v, want %v", got, tt.wantIntersect)
+			}
+			union, err := a.Union(b)
+			if tt.wantUnion == "" {
+				if !errors.Is(err, ErrRangeNotContiguous) {
+					t.Errorf("Union() error = %v, want %v", err, ErrRangeNotContiguous)
+				}
+			} else if err != nil || union.String() != tt.wantUnion {
+				t.Errorf("Union() = %v, %v, want %v", union, err, tt.wantUnion)
+			}
+			if got := a.Gap(b).String(); got != tt.wantGap {
+				t.Errorf("Gap() = %v, want %v", got, tt.wantGap)
+			}
+		})
+	}
+}
+
+func TestDateRangeBounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		r          DateRange
+		wantFirst  LocalDate
+		wantLast   LocalDate
+		wantLength int
+		wantOk     bool
+	}{
+		{
+			name:       "closed",
+			r:          NewClosedDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.December, 31)),
+			wantFirst:  NewLocalDate(2024, time.January, 1),
+			wantLast:   NewLocalDate(2024, time.December, 31),
+			wantLength: 366,
+			wantOk:     true,
+		},
+		{
+			name:       "half open",
+			r:          NewDateRange(NewLocalDate(2023, time.February, 1), NewLocalDate(2023, time.March, 1)),
+			wantFirst:  NewLocalDate(2023, time.February, 1),
+			wantLast:   NewLocalDate(2023, time.February, 28),
+			wantLength: 28,
+			wantOk:     true,
+		},
+		{
+			name:       "empty",
+			r:          EmptyDateRange(),
+			wantLength: 0,
+			wantOk:     true,
+		},
+		{
+			name:      "unbounded upper",
+			r:         NewDateRange(NewLocalDate(2024, time.January, 1), InfinityDate()),
+			wantFirst: NewLocalDate(2024, time.January, 1),
+			wantLast:  InfinityDate(),
+		},
+		{
+			name:      "unbounded lower",
+			r:         NewDateRange(NegInfinityDate(), NewLocalDate(2024, time.January, 1)),
+			wantFirst: NegInfinityDate(),
+			wantLast:  NewLocalDate(2023, time.December, 31),
+		},
+		{
+			name:   "infinity as lower bound",
+			r:      NewDateRange(InfinityDate(), InfinityDate()),
+			wantOk: true,
+		},
+		{
+			name:   "negative infinity as upper bound",
+			r:      NewClosedDateRange(NewLocalDate(2024, time.January, 1), NegInfinityDate()),
+			wantOk: true,
+		},
+		{
+			name: "invalid",
+			r:    DateRange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.First(); !IsEqual(got, tt.wantFirst) {
+				t.Errorf("First() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := tt.r.Last(); !IsEqual(got, tt.wantLast) {
+				t.Errorf("Last() = %v, want %v", got, tt.wantLast)
+			}
+			length, ok := tt.r.Length()
+			if length != tt.wantLength || ok != tt.wantOk {
+				t.Errorf("Length() = %v, %v, want %v, %v", length, ok, tt.wantLength, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestDateRangeOppositeInfinities(t *testing.T) {
+	tests := []struct {
+		name string
+		r    DateRange
+	}{
+		{name: "infinity lower", r: NewDateRange(InfinityDate(), InfinityDate())},
+		{name: "infinity lower, unbounded upper", r: DateRange{Lower: InfinityDate(), Valid: true}},
+		{name: "negative infinity upper", r: NewDateRange(NegInfinityDate(), NegInfinityDate())},
+		{name: "negative infinity upper, finite lower", r: NewClosedDateRange(NewLocalDate(2024, time.January, 1), NegInfinityDate())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.r.IsEmpty() {
+				t.Errorf("IsEmpty() = false for %v, want true", tt.r)
+			}
+			if tt.r.Contains(NewLocalDate(2024, time.June, 1)) {
+				t.Errorf("Contains() = true for %v, want false", tt.r)
+			}
+		})
+	}
+}
+
+func TestDateRangeLastFiniteDate(t *testing.T) {
+	first := NewLocalDate(2024, time.January, 1)
+	last := LocalDate{Days: math.MaxInt32 - 1, Valid: true}
+	r := NewClosedDateRange(first, last)
+	wantLength := int(last.Days-first.Days) + 1
+
+	ranges := map[string]DateRange{
+		"closed":        r,
+		"canonical":     r.Canonical(),
+		"intersect":     r.Intersect(NewDateRange(first, InfinityDate())),
+		"set":           NewDateRangeSet(r).Ranges()[0],
+		"set intersect": NewDateRangeSet(NewDateRange(first, InfinityDate())).Intersect(NewDateRangeSet(r)).Ranges()[0],
+	}
+	union, err := NewClosedDateRange(first, AddDays(first, 10)).Union(NewClosedDateRange(AddDays(first, 5), last))
+	if err != nil {
+		t.Fatalf("Union() error = %v", err)
+	}
+	ranges["union"] = union
+
+	for name, got := range ranges {
+		t.Run(name, func(t *testing.T) {
+			if !got.IsBounded() {
+				t.Errorf("IsBounded() = false for %v, want true", got)
+			}
+			if !got.Equal(r) {
+				t.Errorf("%v is not equal to %v", got, r)
+			}
+			if length, ok := got.Length(); length != wantLength || !ok {
+				t.Errorf("Length() = %v, %v, want %v, true", length, ok, wantLength)
+			}
+			if got.Last() != last || !got.Contains(last) || got.Contains(InfinityDate()) {
+				t.Errorf("Last() = %v, want %v", got.Last(), last)
+			}
+		})
+	}
+}
+
+func TestDateRangeEqual(t *testing.T) {
+	closed := NewClosedDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.January, 31))
+	halfOpen := NewDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.February, 1))
+	if !closed.Equal(halfOpen) {
+		t.Errorf("Expected %v to equal %v", closed, halfOpen)
+	}
+	if got := closed.Canonical(); got != halfOpen {
+		t.Errorf("Canonical() = %+v, want %+v", got, halfOpen)
+	}
+	reversed := NewDateRange(NewLocalDate(2024, time.February, 1), NewLocalDate(2024, time.January, 1))
+	if !reversed.Equal(EmptyDateRange()) {
+		t.Errorf("Expected %v to equal empty", reversed)
+	}
+	if (DateRange{}).Equal(EmptyDateRange()) {
+		t.Errorf("Expected invalid range not to equal empty")
+	}
+}
+
+func TestDateRangeSQL(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  DateRange
+	}{
+		{
+			name:  "string",
+			input: "[2024-01-01,2024-02-01)",
+			want:  NewDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.February, 1)),
+		},
+		{
+			name:  "bytes",
+			input: []byte("[2024-01-01,)"),
+			want:  NewDateRange(NewLocalDate(2024, time.January, 1), InfinityDate()),
+		},
+		{
+			name:  "empty",
+			input: "empty",
+			want:  EmptyDateRange(),
+		},
+		{
+			name: "pgtype range",
+			input: pgtype.Range[pgtype.Date]{
+				Lower:     pgtype.Date{Time: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				Upper:     pgtype.Date{Time: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), Valid: true},
+				LowerType: pgtype.Inclusive,
+				UpperType: pgtype.Inclusive,
+				Valid:     true,
+			},
+			want: NewDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.February, 1)),
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  DateRange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewClosedDateRange(NewLocalDate(2000, time.January, 1), NewLocalDate(2000, time.January, 1))
+			if err := got.Scan(tt.input); err != nil {
+				t.Fatalf("Scan(%v) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			value, err := got.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			if !got.Valid {
+				if value != nil {
+					t.Errorf("Value() = %v, want nil", value)
+				}
+				return
+			}
+			var roundTrip DateRange
+			if err := roundTrip.Scan(value); err != nil {
+				t.Fatalf("Scan(%v) error = %v", value, err)
+			}
+			if !roundTrip.Equal(got) {
+				t.Errorf("round trip = %v, want %v", roundTrip, got)
+			}
+		})
+	}
+
+	var r DateRange
+	if err := r.Scan(42); err == nil {
+		t.Errorf("Expected error when scanning int")
+	}
+}
+
+func TestDateRangePgRange(t *testing.T) {
+	tests := []struct {
+		name string
+		r    DateRange
+		want pgtype.Range[pgtype.Date]
+	}{
+		{
+			name: "bounded",
+			r:    NewClosedDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.January, 31)),
+			want: pgtype.Range[pgtype.Date]{
+				Lower:     pgtype.Date{Time: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				Upper:     pgtype.Date{Time: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				LowerType: pgtype.Inclusive,
+				UpperType: pgtype.Exclusive,
+				Valid:     true,
+			},
+		},
+		{
+			name: "unbounded",
+			r:    NewDateRange(NegInfinityDate(), InfinityDate()),
+			want: pgtype.Range[pgtype.Date]{
+				LowerType: pgtype.Unbounded,
+				UpperType: pgtype.Unbounded,
+				Valid:     true,
+			},
+		},
+		{
+			name: "empty",
+			r:    EmptyDateRange(),
+			want: pgtype.Range[pgtype.Date]{
+				LowerType: pgtype.Empty,
+				UpperType: pgtype.Empty,
+				Valid:     true,
+			},
+		},
+		{
+			name: "invalid",
+			r:    DateRange{},
+			want: pgtype.Range[pgtype.Date]{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.PgRange()
+			if got != tt.want {
+				t.Errorf("PgRange() = %+v, want %+v", got, tt.want)
+			}
+			if back := FromPgRange(got); !back.Equal(tt.r) {
+				t.Errorf("FromPgRange() = %v, want %v", back, tt.r)
+			}
+		})
+	}
+}
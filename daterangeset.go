@@ -0,0 +1,268 @@
+package localdate
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"iter"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DateRangeSet is a set of dates kept as sorted, non-overlapping and
+// non-adjacent ranges, modeled on the PostgreSQL datemultirange type. The zero
+// value is an empty set.
+//
+// Add and Remove update the set in place, while the set algebra methods return
+// new sets and leave their operands untouched.
+type DateRangeSet struct {
+	spans []span
+}
+
+// NewDateRangeSet returns the set of dates covered by any of ranges. Invalid
+// and empty ranges are ignored.
+func NewDateRangeSet(ranges ...DateRange) DateRangeSet {
+	spans := make([]span, 0, len(ranges))
+	for _, r := range ranges {
+		if r.Valid {
+			spans = append(spans, r.span())
+		}
+	}
+	return DateRangeSet{spans: normalizeSpans(spans)}
+}
+
+// normalizeSpans sorts spans and coalesces the ones that overlap or are
+// adjacent. It modifies the given slice.
+func normalizeSpans(spans []span) []span {
+	spans = slices.DeleteFunc(spans, span.empty)
+	slices.SortFunc(spans, func(a, b span) int { return cmp.Compare(a.lo, b.lo) })
+	out := spans[:0]
+	for _, s := range spans {
+		if n := len(out); n > 0 && s.lo <= out[n-1].hi {
+			out[n-1].hi = max(out[n-1].hi, s.hi)
+			continue
+		}
+		out = append(out, s)
+	}
+	return slices.Clip(out)
+}
+
+// Add adds the dates in r to s.
+func (s *DateRangeSet) Add(r DateRange) {
+	if !r.Valid {
+		return
+	}
+	s.spans = normalizeSpans(append(slices.Clone(s.spans), r.span()))
+}
+
+// Remove removes the dates in r from s.
+func (s *DateRangeSet) Remove(r DateRange) {
+	if !r.Valid {
+		return
+	}
+	*s = s.Difference(NewDateRangeSet(r))
+}
+
+// Union returns the dates that are in s or other.
+func (s DateRangeSet) Union(other DateRangeSet) DateRangeSet {
+	return DateRangeSet{spans: normalizeSpans(slices.Concat(s.spans, other.spans))}
+}
+
+// Intersect returns the dates that are in both s and other.
+func (s DateRangeSet) Intersect(other DateRangeSet) DateRangeSet {
+	var out []span
+	i, j := 0, 0
+	for i < len(s.spans) && j < len(other.spans) {
+		a, b := s.spans[i], other.spans[j]
+		if is := a.intersect(b); !is.empty() {
+			out = append(out, is)
+		}
+		if a.hi < b.hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return DateRangeSet{spans: out}
+}
+
+// Difference returns the dates that are in s but not in other.
+func (s DateRangeSet) Difference(other DateRangeSet) DateRangeSet {
+	return s.Intersect(other.Complement())
+}
+
+// Complement returns the dates in (-infinity, infinity) that are not in s.
+func (s DateRangeSet) Complement() DateRangeSet {
+	var out []span
+	lo := int64(spanNegInf)
+	for _, sp := range s.spans {
+		if lo < sp.lo {
+			out = append(out, span{lo: lo, hi: sp.lo})
+		}
+		lo = sp.hi
+	}
+	if lo < spanInf {
+		out = append(out, span{lo: lo, hi: spanInf})
+	}
+	return DateRangeSet{spans: out}
+}
+
+// ContainsDate reports whether d is in s. Infinite dates are contained if s is
+// unbounded in the same direction.
+func (s DateRangeSet) ContainsDate(d LocalDate) bool {
+	if !d.Valid || len(s.spans) == 0 {
+		return false
+	}
+	switch {
+	case d.IsInfinity():
+		return s.spans[len(s.spans)-1].hi == spanInf
+	case d.IsNegInfinity():
+		return s.spans[0].lo == spanNegInf
+	}
+	x := int64(d.Days)
+	i := sort.Search(len(s.spans), func(i int) bool { return s.spans[i].hi > x })
+	return i < len(s.spans) && s.spans[i].lo <= x
+}
+
+// ContainsRange reports whether every date in r is in s.
+func (s DateRangeSet) ContainsRange(r DateRange) bool {
+	if !r.Valid {
+		return false
+	}
+	return NewDateRangeSet(r).Difference(s).IsEmpty()
+}
+
+// IsEmpty reports whether s contains no dates.
+func (s DateRangeSet) IsEmpty() bool {
+	return len(s.spans) == 0
+}
+
+// Len returns the number of ranges in s.
+func (s DateRangeSet) Len() int {
+	return len(s.spans)
+}
+
+// Ranges returns the ranges of s in ascending order, in canonical form.
+func (s DateRangeSet) Ranges() []DateRange {
+	ranges := make([]DateRange, len(s.spans))
+	for i, sp := range s.spans {
+		ranges[i] = rangeFromSpan(sp)
+	}
+	return ranges
+}
+
+// All returns an iterator over the ranges of s in ascending order.
+func (s DateRangeSet) All() iter.Seq[DateRange] {
+	return func(yield func(DateRange) bool) {
+		for _, sp := range s.spans {
+			if !yield(rangeFromSpan(sp)) {
+				return
+			}
+		}
+	}
+}
+
+// Equal reports whether s and other contain exactly the same dates.
+func (s DateRangeSet) Equal(other DateRangeSet) bool {
+	return slices.Equal(s.spans, other.spans)
+}
+
+// String returns s in PostgreSQL's multirange text format, e.g.
+// {[2024-01-01,2024-02-01),[2024-03-01,)} or {}.
+func (s DateRangeSet) String() string {
+	var b strings.Builder
+	b.WriteString("{")
+	for i, sp := range s.spans {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(rangeFromSpan(sp).String())
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// ParseDateRangeSet parses a set in PostgreSQL's multirange text format, e.g.
+// {[2024-01-01,2024-02-01),[2024-03-01,)}. Overlapping and adjacent ranges are
+// coalesced.
+func ParseDateRangeSet(s string) (DateRangeSet, error) {
+	src := strings.TrimSpace(s)
+	if len(src) < 2 || src[0] != '{' || src[len(src)-1] != '}' {
+		return DateRangeSet{}, fmt.Errorf("invalid date multirange %q", s)
+	}
+	src = src[1 : len(src)-1]
+
+	var spans []span
+	for {
+		src = strings.TrimLeft(src, " \t\n,")
+		if src == "" {
+			break
+		}
+		var elem string
+		if len(src) >= 5 && strings.EqualFold(src[:5], "empty") {
+			elem, src = src[:5], src[5:]
+		} else {
+			end := strings.IndexAny(src, "])")
+			if end < 0 {
+				return DateRangeSet{}, fmt.Errorf("invalid date multirange %q: unterminated range", s)
+			}
+			elem, src = src[:end+1], src[end+1:]
+		}
+		r, err := ParseDateRange(elem)
+		if err != nil {
+			return DateRangeSet{}, fmt.Errorf("invalid date multirange %q: %w", s, err)
+		}
+		spans = append(spans, r.span())
+	}
+	return DateRangeSet{spans: normalizeSpans(spans)}, nil
+}
+
+// SQL scanning. NULL is scanned as an empty set.
+func (s *DateRangeSet) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		parsed, err := ParseDateRangeSet(v)
+		if err != nil {
+			return err
+		}
+		*s = parsed
+		return nil
+	case []byte:
+		return s.Scan(string(v))
+	case pgtype.Multirange[pgtype.Range[pgtype.Date]]:
+		*s = FromPgMultirange(v)
+		return nil
+	case nil:
+		*s = DateRangeSet{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported Scan, storing %T into DateRangeSet", value)
+	}
+}
+
+// SQL value
+func (s DateRangeSet) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+// pgtype conversion
+func (s DateRangeSet) PgMultirange() pgtype.Multirange[pgtype.Range[pgtype.Date]] {
+	mr := make(pgtype.Multirange[pgtype.Range[pgtype.Date]], len(s.spans))
+	for i, sp := range s.spans {
+		mr[i] = rangeFromSpan(sp).PgRange()
+	}
+	return mr
+}
+
+// FromPgMultirange converts a pgtype datemultirange to a DateRangeSet. A NULL
+// multirange becomes an empty set.
+func FromPgMultirange(mr pgtype.Multirange[pgtype.Range[pgtype.Date]]) DateRangeSet {
+	ranges := make([]DateRange, len(mr))
+	for i, pr := range mr {
+		ranges[i] = FromPgRange(pr)
+	}
+	return NewDateRangeSet(ranges...)
+}
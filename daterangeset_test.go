@@ -0,0 +1,242 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func mustParseDateRangeSet(t *testing.T, s string) DateRangeSet {
+	t.Helper()
+	set, err := ParseDateRangeSet(s)
+	if err != nil {
+		t.Fatalf("ParseDateRangeSet(%q) error = %v", s, err)
+	}
+	return set
+}
+
+func TestParseDateRangeSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty set", input: "{}", want: "{}"},
+		{name: "single range", input: "{[2024-01-01,2024-02-01)}", want: "{[2024-01-01,2024-02-01)}"},
+		{name: "sorted", input: "{[2024-03-01,2024-04-01),[2024-01-01,2024-02-01)}", want: "{[2024-01-01,2024-02-01),[2024-03-01,2024-04-01)}"},
+		{name: "coalesces overlapping", input: "{[2024-01-01,2024-02-15),[2024-02-01,2024-03-01)}", want: "{[2024-01-01,2024-03-01)}"},
+		{name: "coalesces adjacent", input: "{[2024-01-01,2024-01-31],[2024-02-01,2024-03-01)}", want: "{[2024-01-01,2024-03-01)}"},
+		{name: "drops empty", input: "{empty,[2024-01-01,2024-02-01)}", want: "{[2024-01-01,2024-02-01)}"},
+		{name: "unbounded", input: "{(,2024-01-01), [2024-02-01,)}", want: "{(,2024-01-01),[2024-02-01,)}"},
+		{name: "missing braces", input: "[2024-01-01,2024-02-01)", wantErr: true},
+		{name: "unterminated range", input: "{[2024-01-01,2024-02-01}", wantErr: true},
+		{name: "invalid range", input: "{[2024-13-01,)}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDateRangeSet(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDateRangeSet(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseDateRangeSet(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateRangeSetAddRemove(t *testing.T) {
+	var s DateRangeSet
+	s.Add(mustParseDateRange(t, "[2024-01-01,2024-02-01)"))
+	s.Add(mustParseDateRange(t, "[2024-03-01,2024-04-01)"))
+	s.Add(DateRange{})
+	s.Add(EmptyDateRange())
+	if got, want := s.String(), "{[2024-01-01,2024-02-01),[2024-03-01,2024-04-01)}"; got != want {
+		t.Fatalf("Add() = %v, want %v", got, want)
+	}
+
+	copied := s
+	s.Add(mustParseDateRange(t, "[2024-01-15,2024-03-15)"))
+	if got, want := s.String(), "{[2024-01-01,2024-04-01)}"; got != want {
+		t.Fatalf("Add() = %v, want %v", got, want)
+	}
+	if got, want := copied.String(), "{[2024-01-01,2024-02-01),[2024-03-01,2024-04-01)}"; got != want {
+		t.Errorf("Add() modified copy = %v, want %v", got, want)
+	}
+
+	s.Remove(mustParseDateRange(t, "[2024-02-01,2024-02-29]"))
+	if got, want := s.String(), "{[2024-01-01,2024-02-01),[2024-03-01,2024-04-01)}"; got != want {
+		t.Fatalf("Remove() = %v, want %v", got, want)
+	}
+	s.Remove(mustParseDateRange(t, "[2024-01-10,2024-01-20)"))
+	if got, want := s.String(), "{[2024-01-01,2024-01-10),[2024-01-20,2024-02-01),[2024-03-01,2024-04-01)}"; got != want {
+		t.Fatalf("Remove() = %v, want %v", got, want)
+	}
+	s.Remove(NewDateRange(NegInfinityDate(), InfinityDate()))
+	if !s.IsEmpty() {
+		t.Errorf("Remove() = %v, want empty", s)
+	}
+}
+
+func TestDateRangeSetAlgebra(t *testing.T) {
+	tests := []struct {
+		name           string
+		a              string
+		b              string
+		wantUnion      string
+		wantIntersect  string
+		wantDifference string
+	}{
+		{
+			name:           "disjoint",
+			a:              "{[2024-01-01,2024-02-01)}",
+			b:              "{[2024-03-01,2024-04-01)}",
+			wantUnion:      "{[2024-01-01,2024-02-01),[2024-03-01,2024-04-01)}",
+			wantIntersect:  "{}",
+			wantDifference: "{[2024-01-01,2024-02-01)}",
+		},
+		{
+			name:           "overlapping",
+			a:              "{[2024-01-01,2024-03-01),[2024-05-01,2024-07-01)}",
+			b:              "{[2024-02-01,2024-06-01)}",
+			wantUnion:      "{[2024-01-01,2024-07-01)}",
+			wantIntersect:  "{[2024-02-01,2024-03-01),[2024-05-01,2024-06-01)}",
+			wantDifference: "{[2024-01-01,2024-02-01),[2024-06-01,2024-07-01)}",
+		},
+		{
+			name:           "unbounded",
+			a:              "{(,)}",
+			b:              "{[2024-01-01,2024-02-01)}",
+			wantUnion:      "{(,)}",
+			wantIntersect:  "{[2024-01-01,2024-02-01)}",
+			wantDifference: "{(,2024-01-01),[2024-02-01,)}",
+		},
+		{
+			name:           "empty",
+			a:              "{}",
+			b:              "{[2024-01-01,2024-02-01)}",
+			wantUnion:      "{[2024-01-01,2024-02-01)}",
+			wantIntersect:  "{}",
+			wantDifference: "{}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParseDateRangeSet(t, tt.a), mustParseDateRangeSet(t, tt.b)
+			if got := a.Union(b).String(); got != tt.wantUnion {
+				t.Errorf("Union() = %v, want %v", got, tt.wantUnion)
+			}
+			if got := a.Intersect(b).String(); got != tt.wantIntersect {
+				t.Errorf("Intersect() = %v, want %v", got, tt.wantIntersect)
+			}
+			if got := a.Difference(b).String(); got != tt.wantDifference {
+				t.Errorf("Difference() = %v, want %v", got, tt.wantDifference)
+			}
+			if got := a.Complement().Complement(); !got.Equal(a) {
+				t.Errorf("Complement().Complement() = %v, want %v", got, a)
+			}
+			if got := a.Union(a.Complement()).String(); got != "{(,)}" {
+				t.Errorf("Union(Complement()) = %v, want {(,)}", got)
+			}
+		})
+	}
+}
+
+func TestDateRangeSetContains(t *testing.T) {
+	s := mustParseDateRangeSet(t, "{(,2023-01-01),[2024-01-01,2024-02-01),[2024-03-01,)}")
+
+	tests := []struct {
+		name string
+		date LocalDate
+		want bool
+	}{
+		{name: "in first range", date: NewLocalDate(2022, time.June, 1), want: true},
+		{name: "in gap", date: NewLocalDate(2023, time.June, 1), want: false},
+		{name: "first day of middle range", date: NewLocalDate(2024, time.January, 1), want: true},
+		{name: "exclusive upper of middle range", date: NewLocalDate(2024, time.February, 1), want: false},
+		{name: "in last range", date: NewLocalDate(2030, time.January, 1), want: true},
+		{name: "infinity", date: InfinityDate(), want: true},
+		{name: "negative infinity", date: NegInfinityDate(), want: true},
+		{name: "invalid", date: LocalDate{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.ContainsDate(tt.date); got != tt.want {
+				t.Errorf("ContainsDate(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+
+	if !s.ContainsRange(mustParseDateRange(t, "[2024-01-10,2024-01-20)")) {
+		t.Errorf("Expected set to contain range inside a member")
+	}
+	if s.ContainsRange(mustParseDateRange(t, "[2024-01-10,2024-02-10)")) {
+		t.Errorf("Expected set not to contain range crossing a gap")
+	}
+}
+
+func TestDateRangeSetIteration(t *testing.T) {
+	s := mustParseDateRangeSet(t, "{[2024-03-01,2024-04-01),[2024-01-01,2024-02-01)}")
+	want := []DateRange{
+		NewDateRange(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.February, 1)),
+		NewDateRange(NewLocalDate(2024, time.March, 1), NewLocalDate(2024, time.April, 1)),
+	}
+	if got := slices.Collect(s.All()); !slices.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	if got := s.Ranges(); !slices.Equal(got, want) {
+		t.Errorf("Ranges() = %v, want %v", got, want)
+	}
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len() = %v, want 2", got)
+	}
+}
+
+func TestDateRangeSetSQL(t *testing.T) {
+	want := mustParseDateRangeSet(t, "{[2024-01-01,2024-02-01),[2024-03-01,)}")
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  DateRangeSet
+	}{
+		{name: "string", input: "{[2024-01-01,2024-02-01),[2024-03-01,)}", want: want},
+		{name: "bytes", input: []byte("{[2024-01-01,2024-02-01),[2024-03-01,)}"), want: want},
+		{name: "pgtype multirange", input: want.PgMultirange(), want: want},
+		{name: "nil", input: nil, want: DateRangeSet{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mustParseDateRangeSet(t, "{[2000-01-01,2000-01-02)}")
+			if err := got.Scan(tt.input); err != nil {
+				t.Fatalf("Scan(%v) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Scan(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != "{[2024-01-01,2024-02-01),[2024-03-01,)}" {
+		t.Errorf("Value() = %v", value)
+	}
+
+	mr := want.PgMultirange()
+	if len(mr) != 2 || mr[1].UpperType != pgtype.Unbounded || mr[0].LowerType != pgtype.Inclusive {
+		t.Errorf("PgMultirange() = %+v", mr)
+	}
+	if got := FromPgMultirange(mr); !got.Equal(want) {
+		t.Errorf("FromPgMultirange() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package localdate
+
+import "time"
+
+// DanishCalendar returns the Danish public holidays (helligdage), including
+// Store bededag until its abolition after 2023. Sundays are left to the
+// weekend handling of the caller.
+//
+// If includeDeFacto is set the calendar also contains Grundlovsdag,
+// Juleaftensdag and Nytårsaftensdag, which are commonly days off, with
+// Holiday.DeFacto set.
+func DanishCalendar(includeDeFacto bool) *Calendar {
+	rules := []HolidayRule{
+		FixedDate("Nytårsdag", time.January, 1),
+		EasterOffset("Skærtorsdag", -3),
+		EasterOffset("Langfredag", -2),
+		EasterOffset("Påskedag", 0),
+		EasterOffset("2. påskedag", 1),
+		ValidDuring(EasterOffset("Store bededag", 26), yearsUntil(2023)),
+		EasterOffset("Kristi himmelfartsdag", 39),
+		EasterOffset("Pinsedag", 49),
+		EasterOffset("2. pinsedag", 50),
+		FixedDate("Juledag", time.December, 25),
+		FixedDate("2. juledag", time.December, 26),
+	}
+	if includeDeFacto {
+		rules = append(rules,
+			DeFacto(FixedDate("Grundlovsdag", time.June, 5)),
+			DeFacto(FixedDate("Juleaftensdag", time.December, 24)),
+			DeFacto(FixedDate("Nytårsaftensdag", time.December, 31)),
+		)
+	}
+	return NewCalendar(rules...)
+}
@@ -0,0 +1,61 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDanishCalendar(t *testing.T) {
+	tests := []struct {
+		name    string
+		defacto bool
+		year    int
+		want    []string
+	}{
+		{
+			name: "2023 with Store bededag",
+			year: 2023,
+			want: []string{
+				"2023-01-01 Nytårsdag",
+				"2023-04-06 Skærtorsdag",
+				"2023-04-07 Langfredag",
+				"2023-04-09 Påskedag",
+				"2023-04-10 2. påskedag",
+				"2023-05-05 Store bededag",
+				"2023-05-18 Kristi himmelfartsdag",
+				"2023-05-28 Pinsedag",
+				"2023-05-29 2. pinsedag",
+				"2023-12-25 Juledag",
+				"2023-12-26 2. juledag",
+			},
+		},
+		{
+			name:    "2024 without Store bededag",
+			defacto: true,
+			year:    2024,
+			want: []string{
+				"2024-01-01 Nytårsdag",
+				"2024-03-28 Skærtorsdag",
+				"2024-03-29 Langfredag",
+				"2024-03-31 Påskedag",
+				"2024-04-01 2. påskedag",
+				"2024-05-09 Kristi himmelfartsdag",
+				"2024-05-19 Pinsedag",
+				"2024-05-20 2. pinsedag",
+				"2024-06-05 Grundlovsdag",
+				"2024-12-24 Juleaftensdag",
+				"2024-12-25 Juledag",
+				"2024-12-26 2. juledag",
+				"2024-12-31 Nytårsaftensdag",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := holidayDates(DanishCalendar(tt.defacto).Holidays(tt.year)); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
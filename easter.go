@@ -0,0 +1,81 @@
+package localdate
+
+import "time"
+
+// Feast computes the date of a yearly feast, e.g. WesternEaster.
+type Feast func(year int) LocalDate
+
+// Offset returns the feast days after f, or before f if days is negative.
+func (f Feast) Offset(days int) Feast {
+	return func(year int) LocalDate {
+		return AddDays(f(year), days)
+	}
+}
+
+// WesternEaster returns Easter Sunday in the Gregorian calendar, as observed
+// by the Western churches, using the anonymous Gregorian algorithm.
+func WesternEaster(year int) LocalDate {
+	a := floorMod(int64(year), 19)
+	b, c := floorDiv(int64(year), 100), floorMod(int64(year), 100)
+	d, e := floorDiv(b, 4), floorMod(b, 4)
+	f := floorDiv(b+8, 25)
+	g := floorDiv(b-f+1, 3)
+	h := floorMod(19*a+b-d-g+15, 30)
+	i, k := c/4, c%4
+	l := floorMod(32+2*e+2*i-h-k, 7)
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return NewLocalDate(year, time.Month(month), int(day))
+}
+
+// OrthodoxEaster returns Easter Sunday as observed by the Eastern Orthodox
+// churches, computed in the Julian calendar with Meeus' algorithm and returned
+// as a Gregorian date.
+func OrthodoxEaster(year int) LocalDate {
+	y := int64(year)
+	a, b, c := floorMod(y, 4), floorMod(y, 7), floorMod(y, 19)
+	d := (19*c + 15) % 30
+	e := floorMod(2*a+4*b-d+34, 7)
+	month := (d + e + 114) / 31
+	day := (d+e+114)%31 + 1
+	// the calendars drift apart by three days every 400 years, the Julian
+	// date is always in March, April or May
+	drift := floorDiv(y, 100) - floorDiv(y, 400) - 2
+	return LocalDate{Days: int32(daysFromCivil(y, time.Month(month), int(day)) + drift), Valid: true}
+}
+
+// Feasts derived from Western Easter.
+var (
+	MaundyThursday = Feast(WesternEaster).Offset(-3)
+	GoodFriday     = Feast(WesternEaster).Offset(-2)
+	HolySaturday   = Feast(WesternEaster).Offset(-1)
+	EasterMonday   = Feast(WesternEaster).Offset(1)
+	AscensionDay   = Feast(WesternEaster).Offset(39)
+	Pentecost      = Feast(WesternEaster).Offset(49)
+	WhitMonday     = Feast(WesternEaster).Offset(50)
+	CorpusChristi  = Feast(WesternEaster).Offset(60)
+)
+
+// FixedFeast returns a feast on the same day every year.
+func FixedFeast(month time.Month, day int) Feast {
+	return func(year int) LocalDate {
+		return NewLocalDate(year, month, day)
+	}
+}
+
+// WeekdayBetween returns the feast on the wd among the seven days starting on
+// the given day, e.g. WeekdayBetween(time.Saturday, time.June, 20) for Swedish
+// Midsummer Day, the Saturday between June 20 and 26.
+func WeekdayBetween(wd time.Weekday, month time.Month, day int) Feast {
+	return func(year int) LocalDate {
+		return NewLocalDate(year, month, day).OnOrAfter(wd)
+	}
+}
+
+// FeastDay returns a rule for a holiday on the feast.
+func FeastDay(name string, f Feast) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		return []Holiday{{Date: f(year), Name: name}}
+	})
+}
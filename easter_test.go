@@ -0,0 +1,109 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestWesternEaster(t *testing.T) {
+	tests := []struct {
+		year int
+		want LocalDate
+	}{
+		{year: 1818, want: NewLocalDate(1818, time.March, 22)},
+		{year: 1943, want: NewLocalDate(1943, time.April, 25)},
+		{year: 1961, want: NewLocalDate(1961, time.April, 2)},
+		{year: 2000, want: NewLocalDate(2000, time.April, 23)},
+		{year: 2008, want: NewLocalDate(2008, time.March, 23)},
+		{year: 2016, want: NewLocalDate(2016, time.March, 27)},
+		{year: 2024, want: NewLocalDate(2024, time.March, 31)},
+		{year: 2025, want: NewLocalDate(2025, time.April, 20)},
+		{year: 2026, want: NewLocalDate(2026, time.April, 5)},
+		{year: 2038, want: NewLocalDate(2038, time.April, 25)},
+		{year: 2285, want: NewLocalDate(2285, time.March, 22)},
+	}
+
+	for _, tt := range tests {
+		if got := WesternEaster(tt.year); got != tt.want {
+			t.Errorf("WesternEaster(%d) = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestOrthodoxEaster(t *testing.T) {
+	tests := []struct {
+		year int
+		want LocalDate
+	}{
+		{year: 2008, want: NewLocalDate(2008, time.April, 27)},
+		{year: 2010, want: NewLocalDate(2010, time.April, 4)},
+		{year: 2016, want: NewLocalDate(2016, time.May, 1)},
+		{year: 2021, want: NewLocalDate(2021, time.May, 2)},
+		{year: 2023, want: NewLocalDate(2023, time.April, 16)},
+		{year: 2024, want: NewLocalDate(2024, time.May, 5)},
+		{year: 2025, want: NewLocalDate(2025, time.April, 20)},
+		{year: 2026, want: NewLocalDate(2026, time.April, 12)},
+	}
+
+	for _, tt := range tests {
+		if got := OrthodoxEaster(tt.year); got != tt.want {
+			t.Errorf("OrthodoxEaster(%d) = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestEasterBounds(t *testing.T) {
+	for year := 1583; year < 4100; year++ {
+		western, orthodox := WesternEaster(year), OrthodoxEaster(year)
+		if western.Weekday() != time.Sunday || orthodox.Weekday() != time.Sunday {
+			t.Fatalf("Easter %d is not on a Sunday: %v, %v", year, western, orthodox)
+		}
+		if western.Compare(NewLocalDate(year, time.March, 22)) < 0 || western.Compare(NewLocalDate(year, time.April, 25)) > 0 {
+			t.Fatalf("WesternEaster(%d) = %v, out of range", year, western)
+		}
+		if orthodox.Compare(western) < 0 {
+			t.Fatalf("OrthodoxEaster(%d) = %v, before WesternEaster %v", year, orthodox, western)
+		}
+	}
+}
+
+func TestFeasts(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Feast
+		year int
+		want LocalDate
+	}{
+		{name: "maundy thursday", f: MaundyThursday, year: 2024, want: NewLocalDate(2024, time.March, 28)},
+		{name: "good friday", f: GoodFriday, year: 2024, want: NewLocalDate(2024, time.March, 29)},
+		{name: "holy saturday", f: HolySaturday, year: 2024, want: NewLocalDate(2024, time.March, 30)},
+		{name: "easter monday", f: EasterMonday, year: 2024, want: NewLocalDate(2024, time.April, 1)},
+		{name: "ascension", f: AscensionDay, year: 2024, want: NewLocalDate(2024, time.May, 9)},
+		{name: "pentecost", f: Pentecost, year: 2024, want: NewLocalDate(2024, time.May, 19)},
+		{name: "whit monday", f: WhitMonday, year: 2024, want: NewLocalDate(2024, time.May, 20)},
+		{name: "corpus christi", f: CorpusChristi, year: 2024, want: NewLocalDate(2024, time.May, 30)},
+		{name: "orthodox good friday", f: Feast(OrthodoxEaster).Offset(-2), year: 2024, want: NewLocalDate(2024, time.May, 3)},
+		{name: "fixed", f: FixedFeast(time.December, 24), year: 2024, want: NewLocalDate(2024, time.December, 24)},
+		{name: "midsummer day", f: WeekdayBetween(time.Saturday, time.June, 20), year: 2024, want: NewLocalDate(2024, time.June, 22)},
+		{name: "midsummer on first day", f: WeekdayBetween(time.Saturday, time.June, 20), year: 2026, want: NewLocalDate(2026, time.June, 20)},
+		{name: "midsummer on last day", f: WeekdayBetween(time.Saturday, time.June, 20), year: 2021, want: NewLocalDate(2021, time.June, 26)},
+		{name: "midsummer eve", f: WeekdayBetween(time.Saturday, time.June, 20).Offset(-1), year: 2024, want: NewLocalDate(2024, time.June, 21)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.year); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeastDay(t *testing.T) {
+	rule := FeastDay("Orthodox Easter", OrthodoxEaster)
+	want := []string{"2024-05-05 Orthodox Easter"}
+	if got := holidayDates(rule.Holidays(2024)); !slices.Equal(got, want) {
+		t.Errorf("Holidays(2024) = %v, want %v", got, want)
+	}
+}
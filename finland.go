@@ -0,0 +1,33 @@
+package localdate
+
+import "time"
+
+// FinnishCalendar returns the Finnish public holidays (pyhäpäivät) as observed
+// since 1991. Sundays are left to the weekend handling of the caller.
+//
+// If includeDeFacto is set the calendar also contains Juhannusaatto and
+// Jouluaatto, which are commonly days off, with Holiday.DeFacto set.
+func FinnishCalendar(includeDeFacto bool) *Calendar {
+	rules := []HolidayRule{
+		FixedDate("Uudenvuodenpäivä", time.January, 1),
+		FixedDate("Loppiainen", time.January, 6),
+		EasterOffset("Pitkäperjantai", -2),
+		EasterOffset("Pääsiäispäivä", 0),
+		EasterOffset("2. pääsiäispäivä", 1),
+		FixedDate("Vappu", time.May, 1),
+		EasterOffset("Helatorstai", 39),
+		EasterOffset("Helluntaipäivä", 49),
+		WeekdayOnOrAfter("Juhannuspäivä", time.June, 20, time.Saturday),
+		WeekdayOnOrAfter("Pyhäinpäivä", time.October, 31, time.Saturday),
+		FixedDate("Itsenäisyyspäivä", time.December, 6),
+		FixedDate("Joulupäivä", time.December, 25),
+		FixedDate("Tapaninpäivä", time.December, 26),
+	}
+	if includeDeFacto {
+		rules = append(rules,
+			DeFacto(WeekdayOnOrAfter("Juhannusaatto", time.June, 19, time.Friday)),
+			DeFacto(FixedDate("Jouluaatto", time.December, 24)),
+		)
+	}
+	return NewCalendar(rules...)
+}
@@ -0,0 +1,65 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFinnishCalendar(t *testing.T) {
+	tests := []struct {
+		name    string
+		defacto bool
+		year    int
+		want    []string
+	}{
+		{
+			name:    "2024 with de facto holidays",
+			defacto: true,
+			year:    2024,
+			want: []string{
+				"2024-01-01 Uudenvuodenpäivä",
+				"2024-01-06 Loppiainen",
+				"2024-03-29 Pitkäperjantai",
+				"2024-03-31 Pääsiäispäivä",
+				"2024-04-01 2. pääsiäispäivä",
+				"2024-05-01 Vappu",
+				"2024-05-09 Helatorstai",
+				"2024-05-19 Helluntaipäivä",
+				"2024-06-21 Juhannusaatto",
+				"2024-06-22 Juhannuspäivä",
+				"2024-11-02 Pyhäinpäivä",
+				"2024-12-06 Itsenäisyyspäivä",
+				"2024-12-24 Jouluaatto",
+				"2024-12-25 Joulupäivä",
+				"2024-12-26 Tapaninpäivä",
+			},
+		},
+		{
+			name: "2025",
+			year: 2025,
+			want: []string{
+				"2025-01-01 Uudenvuodenpäivä",
+				"2025-01-06 Loppiainen",
+				"2025-04-18 Pitkäperjantai",
+				"2025-04-20 Pääsiäispäivä",
+				"2025-04-21 2. pääsiäispäivä",
+				"2025-05-01 Vappu",
+				"2025-05-29 Helatorstai",
+				"2025-06-08 Helluntaipäivä",
+				"2025-06-21 Juhannuspäivä",
+				"2025-11-01 Pyhäinpäivä",
+				"2025-12-06 Itsenäisyyspäivä",
+				"2025-12-25 Joulupäivä",
+				"2025-12-26 Tapaninpäivä",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := holidayDates(FinnishCalendar(tt.defacto).Holidays(tt.year)); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
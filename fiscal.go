@@ -0,0 +1,234 @@
+package localdate
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// YearLabel decides which calendar year a fiscal or retail year that spans two
+// calendar years is named after.
+type YearLabel int
+
+const (
+	// LabelEndYear names a year after the calendar year it ends in, so a
+	// fiscal year from 2024-07-01 to 2025-06-30 is fiscal year 2025.
+	LabelEndYear YearLabel = iota
+	// LabelStartYear names a year after the calendar year it starts in, so a
+	// fiscal year from 2024-07-01 to 2025-06-30 is fiscal year 2024.
+	LabelStartYear
+)
+
+// FiscalCalendar is a fiscal year made of twelve fiscal months that each start
+// on StartDay of a calendar month, beginning with StartMonth. The zero value is
+// the calendar year.
+type FiscalCalendar struct {
+	// StartMonth is the calendar month the fiscal year starts in. Zero means
+	// January.
+	StartMonth time.Month
+	// StartDay is the day of month every fiscal month starts on, in [1, 28].
+	// Zero means 1.
+	StartDay int
+	// YearLabel decides how fiscal years are numbered.
+	YearLabel YearLabel
+}
+
+// FiscalPeriod is a year, quarter, month or week of a FiscalCalendar or
+// RetailCalendar.
+type FiscalPeriod struct {
+	// Year is the fiscal year the period belongs to.
+	Year int
+	// Number is the quarter, month or week within the year, counted from 1,
+	// or 0 for the year itself.
+	Number int
+	// Start and End are the first and last day of the period.
+	Start LocalDate
+	End   LocalDate
+}
+
+// Validate returns an error if c has a field out of range.
+func (c FiscalCalendar) Validate() error {
+	var errs []error
+	if c.StartMonth < 0 || c.StartMonth > time.December {
+		errs = append(errs, fmt.Errorf("localdate: fiscal start month %d out of range", c.StartMonth))
+	}
+	if c.StartDay < 0 || c.StartDay > 28 {
+		errs = append(errs, fmt.Errorf("localdate: fiscal start day %d out of range [1, 28]", c.StartDay))
+	}
+	if c.YearLabel != LabelEndYear && c.YearLabel != LabelStartYear {
+		errs = append(errs, fmt.Errorf("localdate: unknown year label %d", c.YearLabel))
+	}
+	return errors.Join(errs...)
+}
+
+func (c FiscalCalendar) startMonth() time.Month {
+	return max(c.StartMonth, time.January)
+}
+
+func (c FiscalCalendar) startDay() int {
+	return max(c.StartDay, 1)
+}
+
+// labelOffset returns the fiscal year label minus the calendar year the fiscal
+// year starts in.
+func (c FiscalCalendar) labelOffset() int {
+	if c.YearLabel == LabelStartYear || (c.startMonth() == time.January && c.startDay() == 1) {
+		return 0
+	}
+	return 1
+}
+
+// monthIndex returns the number of fiscal months from the first fiscal month
+// of calendar year 0 to the one containing d, which must be finite.
+func (c FiscalCalendar) monthIndex(d LocalDate) int64 {
+	year, month, day := d.Date()
+	n := int64(year)*12 + int64(month-c.startMonth())
+	if day < c.startDay() {
+		n--
+	}
+	return n
+}
+
+// monthStart returns the first day of the fiscal month with the given index.
+func (c FiscalCalendar) monthStart(n int64) LocalDate {
+	ym := yearMonthFromIndex(n + int64(c.startMonth()-1))
+	return LocalDate{Days: int32(daysFromCivil(int64(ym.Year), ym.Month, c.startDay())), Valid: true}
+}
+
+// period returns the period of months fiscal months starting at index n.
+func (c FiscalCalendar) period(n int64, months int64) FiscalPeriod {
+	number := int(floorMod(n, 12)/months) + 1
+	if months == 12 {
+		number = 0
+	}
+	return FiscalPeriod{
+		Year:   int(floorDiv(n, 12)) + c.labelOffset(),
+		Number: number,
+		Start:  c.monthStart(n),
+		End:    AddDays(c.monthStart(n+months), -1),
+	}
+}
+
+// containing returns the period of months fiscal months containing d, or the
+// zero FiscalPeriod if d is not finite.
+func (c FiscalCalendar) containing(d LocalDate, months int64) FiscalPeriod {
+	if !d.IsFinite() {
+		return FiscalPeriod{}
+	}
+	n := c.monthIndex(d)
+	return c.period(n-floorMod(n, months), months)
+}
+
+// Year returns the fiscal year containing d, or the zero FiscalPeriod if d is
+// not finite.
+func (c FiscalCalendar) Year(d LocalDate) FiscalPeriod {
+	return c.containing(d, 12)
+}
+
+// Quarter returns the fiscal quarter containing d, or the zero FiscalPeriod if
+// d is not finite.
+func (c FiscalCalendar) Quarter(d LocalDate) FiscalPeriod {
+	return c.containing(d, 3)
+}
+
+// Month returns the fiscal month containing d, or the zero FiscalPeriod if d
+// is not finite.
+func (c FiscalCalendar) Month(d LocalDate) FiscalPeriod {
+	return c.containing(d, 1)
+}
+
+// firstMonth returns the index of the first fiscal month of the fiscal year.
+func (c FiscalCalendar) firstMonth(year int) int64 {
+	return int64(year-c.labelOffset()) * 12
+}
+
+// FiscalYear returns the given fiscal year.
+func (c FiscalCalendar) FiscalYear(year int) FiscalPeriod {
+	return c.period(c.firstMonth(year), 12)
+}
+
+// FiscalQuarter returns quarter q of the given fiscal year. Quarters outside
+// [1, 4] continue into adjacent fiscal years.
+func (c FiscalCalendar) FiscalQuarter(year, q int) FiscalPeriod {
+	return c.period(c.firstMonth(year)+int64(q-1)*3, 3)
+}
+
+// FiscalMonth returns month m of the given fiscal year. Months outside
+// [1, 12] continue into adjacent fiscal years.
+func (c FiscalCalendar) FiscalMonth(year, m int) FiscalPeriod {
+	return c.period(c.firstMonth(year)+int64(m-1), 1)
+}
+
+// Quarters returns an iterator over the four quarters of the given fiscal
+// year.
+func (c FiscalCalendar) Quarters(year int) iter.Seq[FiscalPeriod] {
+	return c.periods(c.firstMonth(year), c.firstMonth(year)+9, 3)
+}
+
+// Months returns an iterator over the twelve months of the given fiscal year.
+func (c FiscalCalendar) Months(year int) iter.Seq[FiscalPeriod] {
+	return c.periods(c.firstMonth(year), c.firstMonth(year)+11, 1)
+}
+
+// EachYear returns an iterator over the fiscal years from the one containing
+// from to the one containing to, iterating backward if to is before from. It
+// returns ErrInfiniteDate or ErrInvalidDate if from or to is not finite.
+func (c FiscalCalendar) EachYear(from, to LocalDate) (iter.Seq[FiscalPeriod], error) {
+	return c.each(from, to, 12)
+}
+
+// EachQuarter is like EachYear for fiscal quarters.
+func (c FiscalCalendar) EachQuarter(from, to LocalDate) (iter.Seq[FiscalPeriod], error) {
+	return c.each(from, to, 3)
+}
+
+// EachMonth is like EachYear for fiscal months.
+func (c FiscalCalendar) EachMonth(from, to LocalDate) (iter.Seq[FiscalPeriod], error) {
+	return c.each(from, to, 1)
+}
+
+func (c FiscalCalendar) each(from, to LocalDate, months int64) (iter.Seq[FiscalPeriod], error) {
+	if err := checkFinite(from, to); err != nil {
+		return nil, err
+	}
+	first, last := c.monthIndex(from), c.monthIndex(to)
+	return c.periods(first-floorMod(first, months), last-floorMod(last, months), months), nil
+}
+
+// periods iterates over the periods of months fiscal months starting at the
+// indexes first to last.
+func (c FiscalCalendar) periods(first, last, months int64) iter.Seq[FiscalPeriod] {
+	step := months
+	if last < first {
+		step = -months
+	}
+	return func(yield func(FiscalPeriod) bool) {
+		for n := first; ; n += step {
+			if !yield(c.period(n, months)) || n == last {
+				return
+			}
+		}
+	}
+}
+
+// Contains reports whether d is in p.
+func (p FiscalPeriod) Contains(d LocalDate) bool {
+	return p.Start.Valid && IsBetween(d, p.Start, p.End) && d.IsFinite()
+}
+
+// Days returns the number of days in p.
+func (p FiscalPeriod) Days() int {
+	if !p.Start.Valid {
+		return 0
+	}
+	return int(p.End.Days-p.Start.Days) + 1
+}
+
+// Range returns p as a DateRange.
+func (p FiscalPeriod) Range() DateRange {
+	if !p.Start.Valid {
+		return DateRange{}
+	}
+	return NewClosedDateRange(p.Start, p.End)
+}
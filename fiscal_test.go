@@ -0,0 +1,189 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFiscalCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cal     FiscalCalendar
+		wantErr bool
+	}{
+		{name: "zero value", cal: FiscalCalendar{}},
+		{name: "july", cal: FiscalCalendar{StartMonth: time.July}},
+		{name: "start day", cal: FiscalCalendar{StartMonth: time.September, StartDay: 28, YearLabel: LabelStartYear}},
+		{name: "month out of range", cal: FiscalCalendar{StartMonth: 13}, wantErr: true},
+		{name: "day out of range", cal: FiscalCalendar{StartDay: 29}, wantErr: true},
+		{name: "unknown label", cal: FiscalCalendar{YearLabel: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cal.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFiscalCalendar(t *testing.T) {
+	july := FiscalCalendar{StartMonth: time.July}
+	julyStart := FiscalCalendar{StartMonth: time.July, YearLabel: LabelStartYear}
+	uk := FiscalCalendar{StartMonth: time.April, StartDay: 6}
+
+	tests := []struct {
+		name        string
+		cal         FiscalCalendar
+		date        LocalDate
+		wantYear    FiscalPeriod
+		wantQuarter FiscalPeriod
+		wantMonth   FiscalPeriod
+	}{
+		{
+			name:        "calendar year",
+			cal:         FiscalCalendar{},
+			date:        NewLocalDate(2024, time.May, 15),
+			wantYear:    FiscalPeriod{2024, 0, NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.December, 31)},
+			wantQuarter: FiscalPeriod{2024, 2, NewLocalDate(2024, time.April, 1), NewLocalDate(2024, time.June, 30)},
+			wantMonth:   FiscalPeriod{2024, 5, NewLocalDate(2024, time.May, 1), NewLocalDate(2024, time.May, 31)},
+		},
+		{
+			name:        "july labeled by end year",
+			cal:         july,
+			date:        NewLocalDate(2024, time.August, 20),
+			wantYear:    FiscalPeriod{2025, 0, NewLocalDate(2024, time.July, 1), NewLocalDate(2025, time.June, 30)},
+			wantQuarter: FiscalPeriod{2025, 1, NewLocalDate(2024, time.July, 1), NewLocalDate(2024, time.September, 30)},
+			wantMonth:   FiscalPeriod{2025, 2, NewLocalDate(2024, time.August, 1), NewLocalDate(2024, time.August, 31)},
+		},
+		{
+			name:        "july last day",
+			cal:         july,
+			date:        NewLocalDate(2024, time.June, 30),
+			wantYear:    FiscalPeriod{2024, 0, NewLocalDate(2023, time.July, 1), NewLocalDate(2024, time.June, 30)},
+			wantQuarter: FiscalPeriod{2024, 4, NewLocalDate(2024, time.April, 1), NewLocalDate(2024, time.June, 30)},
+			wantMonth:   FiscalPeriod{2024, 12, NewLocalDate(2024, time.June, 1), NewLocalDate(2024, time.June, 30)},
+		},
+		{
+			name:        "july labeled by start year",
+			cal:         julyStart,
+			date:        NewLocalDate(2025, time.January, 10),
+			wantYear:    FiscalPeriod{2024, 0, NewLocalDate(2024, time.July, 1), NewLocalDate(2025, time.June, 30)},
+			wantQuarter: FiscalPeriod{2024, 3, NewLocalDate(2025, time.January, 1), NewLocalDate(2025, time.March, 31)},
+			wantMonth:   FiscalPeriod{2024, 7, NewLocalDate(2025, time.January, 1), NewLocalDate(2025, time.January, 31)},
+		},
+		{
+			name:        "start day before",
+			cal:         uk,
+			date:        NewLocalDate(2024, time.April, 5),
+			wantYear:    FiscalPeriod{2024, 0, NewLocalDate(2023, time.April, 6), NewLocalDate(2024, time.April, 5)},
+			wantQuarter: FiscalPeriod{2024, 4, NewLocalDate(2024, time.January, 6), NewLocalDate(2024, time.April, 5)},
+			wantMonth:   FiscalPeriod{2024, 12, NewLocalDate(2024, time.March, 6), NewLocalDate(2024, time.April, 5)},
+		},
+		{
+			name:        "start day on",
+			cal:         uk,
+			date:        NewLocalDate(2024, time.April, 6),
+			wantYear:    FiscalPeriod{2025, 0, NewLocalDate(2024, time.April, 6), NewLocalDate(2025, time.April, 5)},
+			wantQuarter: FiscalPeriod{2025, 1, NewLocalDate(2024, time.April, 6), NewLocalDate(2024, time.July, 5)},
+			wantMonth:   FiscalPeriod{2025, 1, NewLocalDate(2024, time.April, 6), NewLocalDate(2024, time.May, 5)},
+		},
+		{
+			name: "infinity",
+			cal:  july,
+			date: InfinityDate(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.Year(tt.date); got != tt.wantYear {
+				t.Errorf("Year() = %+v, want %+v", got, tt.wantYear)
+			}
+			if got := tt.cal.Quarter(tt.date); got != tt.wantQuarter {
+				t.Errorf("Quarter() = %+v, want %+v", got, tt.wantQuarter)
+			}
+			if got := tt.cal.Month(tt.date); got != tt.wantMonth {
+				t.Errorf("Month() = %+v, want %+v", got, tt.wantMonth)
+			}
+			if got := tt.cal.FiscalYear(tt.wantYear.Year); tt.date.IsFinite() && got != tt.wantYear {
+				t.Errorf("FiscalYear() = %+v, want %+v", got, tt.wantYear)
+			}
+			if got := tt.cal.FiscalQuarter(tt.wantQuarter.Year, tt.wantQuarter.Number); tt.date.IsFinite() && got != tt.wantQuarter {
+				t.Errorf("FiscalQuarter() = %+v, want %+v", got, tt.wantQuarter)
+			}
+			if got := tt.cal.FiscalMonth(tt.wantMonth.Year, tt.wantMonth.Number); tt.date.IsFinite() && got != tt.wantMonth {
+				t.Errorf("FiscalMonth() = %+v, want %+v", got, tt.wantMonth)
+			}
+		})
+	}
+}
+
+func TestFiscalCalendarIteration(t *testing.T) {
+	cal := FiscalCalendar{StartMonth: time.September}
+
+	var starts []LocalDate
+	for q := range cal.Quarters(2025) {
+		starts = append(starts, q.Start)
+	}
+	want := []LocalDate{
+		NewLocalDate(2024, time.September, 1),
+		NewLocalDate(2024, time.December, 1),
+		NewLocalDate(2025, time.March, 1),
+		NewLocalDate(2025, time.June, 1),
+	}
+	if !slices.Equal(starts, want) {
+		t.Errorf("Quarters() starts = %v, want %v", starts, want)
+	}
+
+	months := slices.Collect(cal.Months(2025))
+	if len(months) != 12 || months[0].Number != 1 || months[11].End != NewLocalDate(2025, time.August, 31) {
+		t.Errorf("Months() = %+v", months)
+	}
+
+	seq, err := cal.EachQuarter(NewLocalDate(2024, time.November, 15), NewLocalDate(2025, time.September, 1))
+	if err != nil {
+		t.Fatalf("EachQuarter() error = %v", err)
+	}
+	var got [][2]int
+	for p := range seq {
+		got = append(got, [2]int{p.Year, p.Number})
+	}
+	if want := [][2]int{{2025, 1}, {2025, 2}, {2025, 3}, {2025, 4}, {2026, 1}}; !slices.Equal(got, want) {
+		t.Errorf("EachQuarter() = %v, want %v", got, want)
+	}
+
+	seq, err = cal.EachYear(NewLocalDate(2025, time.September, 1), NewLocalDate(2023, time.August, 31))
+	if err != nil {
+		t.Fatalf("EachYear() error = %v", err)
+	}
+	var years []int
+	for p := range seq {
+		years = append(years, p.Year)
+	}
+	if want := []int{2026, 2025, 2024, 2023}; !slices.Equal(years, want) {
+		t.Errorf("EachYear() = %v, want %v", years, want)
+	}
+
+	if _, err := cal.EachMonth(NewLocalDate(2024, time.January, 1), InfinityDate()); err == nil {
+		t.Errorf("Expected error iterating to infinity")
+	}
+}
+
+func TestFiscalPeriod(t *testing.T) {
+	p := FiscalCalendar{StartMonth: time.July}.FiscalYear(2024)
+	if got := p.Days(); got != 366 {
+		t.Errorf("Days() = %v, want 366", got)
+	}
+	if !p.Contains(NewLocalDate(2024, time.February, 29)) || p.Contains(NewLocalDate(2024, time.July, 1)) {
+		t.Errorf("Contains() mismatch for %+v", p)
+	}
+	if got, want := p.Range().String(), "[2023-07-01,2024-07-01)"; got != want {
+		t.Errorf("Range() = %v, want %v", got, want)
+	}
+	if (FiscalPeriod{}).Contains(NewLocalDate(2024, time.January, 1)) || (FiscalPeriod{}).Days() != 0 {
+		t.Errorf("Expected zero FiscalPeriod to be empty")
+	}
+}
@@ -0,0 +1,90 @@
+package localdate
+
+import (
+	"fmt"
+)
+
+// String returns d in the format 2006-01-02, infinity or -infinity, or an
+// empty string if d is invalid.
+func (d LocalDate) String() string {
+	b, _ := d.AppendText(make([]byte, 0, 10))
+	return string(b)
+}
+
+// AppendText implements encoding.TextAppender using the same format as String.
+// Years outside [0, 9999] are written with as many digits as needed and an
+// optional minus sign. UnmarshalText parses them back, At does not.
+func (d LocalDate) AppendText(b []byte) ([]byte, error) {
+	switch {
+	case !d.Valid:
+		return b, nil
+	case d.IsInfinity():
+		return append(b, infinityLiteral...), nil
+	case d.IsNegInfinity():
+		return append(b, negInfinityLiteral...), nil
+	}
+	year, month, day := d.Date()
+	b = appendYear(b, year)
+	b = append(b, '-')
+	b = appendInt(b, int(month), 2)
+	b = append(b, '-')
+	return appendInt(b, day, 2), nil
+}
+
+// appendInt appends the decimal form of the non-negative n, zero padded to
+// width digits.
+func appendInt(b []byte, n int, width int) []byte {
+	var buf [20]byte
+	i := len(buf)
+	for n >= 10 || width > 1 {
+		i--
+		buf[i] = byte('0' + n%10)
+		n /= 10
+		width--
+	}
+	i--
+	buf[i] = byte('0' + n)
+	return append(b, buf[i:]...)
+}
+
+// MarshalText implements encoding.TextMarshaler using the same format as
+// String, so an invalid date marshals to empty text.
+func (d LocalDate) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the format
+// 2006-01-02 with the extended years AppendText writes, infinity and
+// -infinity, and empty text as an invalid date.
+func (d *LocalDate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = LocalDate{}
+		return nil
+	}
+	parsed, err := parseLocalDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Format implements fmt.Formatter. The verbs %s and %v print d as String does
+// and %q prints it quoted, all honoring width and flags. %d prints the number
+// of days since 1970-01-01 and %#v prints the Go syntax representation.
+func (d LocalDate) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprintf(f, "localdate.LocalDate{Days:%d, Valid:%t}", d.Days, d.Valid)
+			return
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), d.String())
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), d.String())
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), d.Days)
+	default:
+		fmt.Fprintf(f, "%%!%c(localdate.LocalDate=%s)", verb, d.String())
+	}
+}
@@ -0,0 +1,203 @@
+package localdate
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name string
+		date LocalDate
+		want string
+	}{
+		{name: "regular date", date: NewLocalDate(2023, time.May, 15), want: "2023-05-15"},
+		{name: "min date", date: NewLocalDate(1, time.January, 1), want: "0001-01-01"},
+		{name: "max date", date: NewLocalDate(9999, time.December, 31), want: "9999-12-31"},
+		{name: "year 0", date: LocalDate{Days: -719528, Valid: true}, want: "0000-01-01"},
+		{name: "negative year", date: LocalDate{Days: -719529, Valid: true}, want: "-0001-12-31"},
+		{name: "five digit year", date: NewLocalDate(10000, time.January, 1), want: "10000-01-01"},
+		{name: "infinity", date: InfinityDate(), want: "infinity"},
+		{name: "negative infinity", date: NegInfinityDate(), want: "-infinity"},
+		{name: "invalid", date: LocalDate{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			text, err := tt.date.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", text, tt.want)
+			}
+			appended, err := tt.date.AppendText([]byte("x"))
+			if err != nil {
+				t.Fatalf("AppendText() error = %v", err)
+			}
+			if string(appended) != "x"+tt.want {
+				t.Errorf("AppendText() = %q, want %q", appended, "x"+tt.want)
+			}
+			var back LocalDate
+			if err := back.UnmarshalText(text); err != nil || back != tt.date {
+				t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, back, err, tt.date)
+			}
+		})
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    LocalDate
+		wantErr bool
+	}{
+		{name: "regular date", text: "2023-05-15", want: NewLocalDate(2023, time.May, 15)},
+		{name: "infinity", text: "infinity", want: InfinityDate()},
+		{name: "negative infinity", text: "-infinity", want: NegInfinityDate()},
+		{name: "empty", text: "", want: LocalDate{}},
+		{name: "five digit year", text: "10000-01-01", want: NewLocalDate(10000, time.January, 1)},
+		{name: "negative year", text: "-0001-12-31", want: LocalDate{Days: -719529, Valid: true}},
+		{name: "postgres max date", text: "5874897-12-31", want: NewLocalDate(5874897, time.December, 31)},
+		{name: "beyond LocalDate range", text: "9999999-01-01", wantErr: true},
+		{name: "nonexistent extended date", text: "10001-02-29", wantErr: true},
+		{name: "short negative year", text: "-1-01-01", wantErr: true},
+		{name: "signed month", text: "10000-+1-01", wantErr: true},
+		{name: "invalid format", text: "15/05/2023", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewLocalDate(2000, time.January, 1)
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextMarshalerIntegration(t *testing.T) {
+	t.Run("JSON map key", func(t *testing.T) {
+		m := map[LocalDate]int{
+			NewLocalDate(2023, time.May, 15): 1,
+			InfinityDate():                   2,
+		}
+		data, err := json.Marshal(m)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if want := `{"2023-05-15":1,"infinity":2}`; string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+		var back map[LocalDate]int
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if len(back) != 2 || back[NewLocalDate(2023, time.May, 15)] != 1 || back[InfinityDate()] != 2 {
+			t.Errorf("Unmarshal() = %v, want %v", back, m)
+		}
+	})
+
+	t.Run("JSON extended years", func(t *testing.T) {
+		for _, d := range []LocalDate{NewLocalDate(10000, time.January, 1), NewLocalDate(-1, time.December, 31)} {
+			data, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("Marshal(%v) error = %v", d, err)
+			}
+			var back LocalDate
+			if err := json.Unmarshal(data, &back); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", data, err)
+			}
+			if back != d {
+				t.Errorf("Unmarshal(%s) = %v, want %v", data, back, d)
+			}
+			var scanned LocalDate
+			if err := scanned.Scan(d.String()); err != nil || scanned != d {
+				t.Errorf("Scan(%q) = %v, %v, want %v", d.String(), scanned, err, d)
+			}
+		}
+	})
+
+	t.Run("XML attribute", func(t *testing.T) {
+		type period struct {
+			From LocalDate `xml:"from,attr"`
+			To   LocalDate `xml:"to,attr"`
+		}
+		p := period{From: NewLocalDate(2023, time.May, 15), To: InfinityDate()}
+		data, err := xml.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if want := `<period from="2023-05-15" to="infinity"></period>`; string(data) != want {
+			t.Errorf("Marshal() = %s, want %s", data, want)
+		}
+		var back period
+		if err := xml.Unmarshal(data, &back); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if back != p {
+			t.Errorf("Unmarshal() = %v, want %v", back, p)
+		}
+	})
+
+	t.Run("flag.TextVar", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		var d LocalDate
+		fs.TextVar(&d, "date", NewLocalDate(2023, time.January, 1), "date")
+		if want := NewLocalDate(2023, time.January, 1); d != want {
+			t.Errorf("default = %v, want %v", d, want)
+		}
+		if err := fs.Parse([]string{"-date", "2023-05-15"}); err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if want := NewLocalDate(2023, time.May, 15); d != want {
+			t.Errorf("Parse() = %v, want %v", d, want)
+		}
+		if err := fs.Parse([]string{"-date", "not-a-date"}); err == nil {
+			t.Errorf("Expected error for invalid date")
+		}
+	})
+}
+
+func TestFormat(t *testing.T) {
+	d := NewLocalDate(2023, time.May, 15)
+
+	tests := []struct {
+		format string
+		date   LocalDate
+		want   string
+	}{
+		{format: "%v", date: d, want: "2023-05-15"},
+		{format: "%s", date: d, want: "2023-05-15"},
+		{format: "%+v", date: d, want: "2023-05-15"},
+		{format: "%q", date: d, want: `"2023-05-15"`},
+		{format: "%12s|", date: d, want: "  2023-05-15|"},
+		{format: "%-12v|", date: d, want: "2023-05-15  |"},
+		{format: "%d", date: d, want: "19492"},
+		{format: "%#v", date: d, want: "localdate.LocalDate{Days:19492, Valid:true}"},
+		{format: "%v", date: InfinityDate(), want: "infinity"},
+		{format: "%q", date: LocalDate{}, want: `""`},
+		{format: "%x", date: d, want: "%!x(localdate.LocalDate=2023-05-15)"},
+		{format: "%v", date: LocalDate{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.date); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
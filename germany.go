@@ -0,0 +1,111 @@
+package localdate
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// GermanState is the ISO 3166-2 code of a German state, without the DE-
+// prefix.
+type GermanState string
+
+const (
+	// GermanyNational selects only the holidays common to all states.
+	GermanyNational GermanState = ""
+
+	GermanyBW GermanState = "BW" // Baden-Württemberg
+	GermanyBY GermanState = "BY" // Bayern
+	GermanyBE GermanState = "BE" // Berlin
+	GermanyBB GermanState = "BB" // Brandenburg
+	GermanyHB GermanState = "HB" // Bremen
+	GermanyHH GermanState = "HH" // Hamburg
+	GermanyHE GermanState = "HE" // Hessen
+	GermanyMV GermanState = "MV" // Mecklenburg-Vorpommern
+	GermanyNI GermanState = "NI" // Niedersachsen
+	GermanyNW GermanState = "NW" // Nordrhein-Westfalen
+	GermanyRP GermanState = "RP" // Rheinland-Pfalz
+	GermanySL GermanState = "SL" // Saarland
+	GermanySN GermanState = "SN" // Sachsen
+	GermanyST GermanState = "ST" // Sachsen-Anhalt
+	GermanySH GermanState = "SH" // Schleswig-Holstein
+	GermanyTH GermanState = "TH" // Thüringen
+)
+
+var germanStates = []GermanState{
+	GermanyBW, GermanyBY, GermanyBE, GermanyBB, GermanyHB, GermanyHH, GermanyHE, GermanyMV,
+	GermanyNI, GermanyNW, GermanyRP, GermanySL, GermanySN, GermanyST, GermanySH, GermanyTH,
+}
+
+// GermanCalendar returns the public holidays (gesetzliche Feiertage) of the
+// German state, or the nationwide ones for GermanyNational. Holidays that
+// only apply in some municipalities of a state, such as Mariä Himmelfahrt in
+// Bayern or Fronleichnam in parts of Sachsen and Thüringen, are not included.
+func GermanCalendar(state GermanState) (*Calendar, error) {
+	if state != GermanyNational && !slices.Contains(germanStates, state) {
+		return nil, fmt.Errorf("localdate: unknown German state %q", state)
+	}
+	in := func(states ...GermanState) bool {
+		return slices.Contains(states, state)
+	}
+
+	rules := []HolidayRule{
+		FixedDate("Neujahr", time.January, 1),
+		EasterOffset("Karfreitag", -2),
+		EasterOffset("Ostermontag", 1),
+		FixedDate("Tag der Arbeit", time.May, 1),
+		EasterOffset("Christi Himmelfahrt", 39),
+		EasterOffset("Pfingstmontag", 50),
+		ValidDuring(FixedDate("Tag der Deutschen Einheit", time.October, 3), yearsFrom(1990)),
+		FixedDate("1. Weihnachtstag", time.December, 25),
+		FixedDate("2. Weihnachtstag", time.December, 26),
+	}
+	if in(GermanyBW, GermanyBY, GermanyST) {
+		rules = append(rules, FixedDate("Heilige Drei Könige", time.January, 6))
+	}
+	if in(GermanyBE) {
+		rules = append(rules, ValidDuring(FixedDate("Internationaler Frauentag", time.March, 8), yearsFrom(2019)))
+		rules = append(rules,
+			OneOff("Tag der Befreiung", NewLocalDate(2020, time.May, 8)),
+			OneOff("Tag der Befreiung", NewLocalDate(2025, time.May, 8)),
+		)
+	}
+	if in(GermanyMV) {
+		rules = append(rules, ValidDuring(FixedDate("Internationaler Frauentag", time.March, 8), yearsFrom(2023)))
+	}
+	if in(GermanyBB) {
+		rules = append(rules, EasterOffset("Ostersonntag", 0), EasterOffset("Pfingstsonntag", 49))
+	}
+	if in(GermanyBW, GermanyBY, GermanyHE, GermanyNW, GermanyRP, GermanySL) {
+		rules = append(rules, EasterOffset("Fronleichnam", 60))
+	}
+	if in(GermanySL) {
+		rules = append(rules, FixedDate("Mariä Himmelfahrt", time.August, 15))
+	}
+	if in(GermanyTH) {
+		rules = append(rules, ValidDuring(FixedDate("Weltkindertag", time.September, 20), yearsFrom(2019)))
+	}
+
+	reformation := FixedDate("Reformationstag", time.October, 31)
+	switch {
+	case in(GermanyBB, GermanyMV, GermanySN, GermanyST, GermanyTH):
+		rules = append(rules, reformation)
+	case in(GermanyHB, GermanyHH, GermanyNI, GermanySH):
+		// nationwide in 2017 and a state holiday from 2018
+		rules = append(rules, ValidDuring(reformation, NewDateRange(NewLocalDate(2017, time.October, 31), InfinityDate())))
+	default:
+		// the 500th anniversary of the Reformation was a nationwide holiday
+		rules = append(rules, OneOff("Reformationstag", NewLocalDate(2017, time.October, 31)))
+	}
+
+	if in(GermanyBW, GermanyBY, GermanyNW, GermanyRP, GermanySL) {
+		rules = append(rules, FixedDate("Allerheiligen", time.November, 1))
+	}
+	repentance := WeekdayOnOrAfter("Buß- und Bettag", time.November, 16, time.Wednesday)
+	if in(GermanySN) {
+		rules = append(rules, repentance)
+	} else {
+		rules = append(rules, ValidDuring(repentance, yearsUntil(1994)))
+	}
+	return NewCalendar(rules...), nil
+}
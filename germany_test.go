@@ -0,0 +1,176 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestGermanCalendar(t *testing.T) {
+	tests := []struct {
+		name  string
+		state GermanState
+		year  int
+		want  []string
+	}{
+		{
+			name:  "national",
+			state: GermanyNational,
+			year:  2024,
+			want: []string{
+				"2024-01-01 Neujahr",
+				"2024-03-29 Karfreitag",
+				"2024-04-01 Ostermontag",
+				"2024-05-01 Tag der Arbeit",
+				"2024-05-09 Christi Himmelfahrt",
+				"2024-05-20 Pfingstmontag",
+				"2024-10-03 Tag der Deutschen Einheit",
+				"2024-12-25 1. Weihnachtstag",
+				"2024-12-26 2. Weihnachtstag",
+			},
+		},
+		{
+			name:  "Bayern",
+			state: GermanyBY,
+			year:  2024,
+			want: []string{
+				"2024-01-01 Neujahr",
+				"2024-01-06 Heilige Drei Könige",
+				"2024-03-29 Karfreitag",
+				"2024-04-01 Ostermontag",
+				"2024-05-01 Tag der Arbeit",
+				"2024-05-09 Christi Himmelfahrt",
+				"2024-05-20 Pfingstmontag",
+				"2024-05-30 Fronleichnam",
+				"2024-10-03 Tag der Deutschen Einheit",
+				"2024-11-01 Allerheiligen",
+				"2024-12-25 1. Weihnachtstag",
+				"2024-12-26 2. Weihnachtstag",
+			},
+		},
+		{
+			name:  "Berlin",
+			state: GermanyBE,
+			year:  2025,
+			want: []string{
+				"2025-01-01 Neujahr",
+				"2025-03-08 Internationaler Frauentag",
+				"2025-04-18 Karfreitag",
+				"2025-04-21 Ostermontag",
+				"2025-05-01 Tag der Arbeit",
+				"2025-05-08 Tag der Befreiung",
+				"2025-05-29 Christi Himmelfahrt",
+				"2025-06-09 Pfingstmontag",
+				"2025-10-03 Tag der Deutschen Einheit",
+				"2025-12-25 1. Weihnachtstag",
+				"2025-12-26 2. Weihnachtstag",
+			},
+		},
+		{
+			name:  "Brandenburg",
+			state: GermanyBB,
+			year:  2024,
+			want: []string{
+				"2024-01-01 Neujahr",
+				"2024-03-29 Karfreitag",
+				"2024-03-31 Ostersonntag",
+				"2024-04-01 Ostermontag",
+				"2024-05-01 Tag der Arbeit",
+				"2024-05-09 Christi Himmelfahrt",
+				"2024-05-19 Pfingstsonntag",
+				"2024-05-20 Pfingstmontag",
+				"2024-10-03 Tag der Deutschen Einheit",
+				"2024-10-31 Reformationstag",
+				"2024-12-25 1. Weihnachtstag",
+				"2024-12-26 2. Weihnachtstag",
+			},
+		},
+		{
+			name:  "Sachsen",
+			state: GermanySN,
+			year:  2024,
+			want: []string{
+				"2024-01-01 Neujahr",
+				"2024-03-29 Karfreitag",
+				"2024-04-01 Ostermontag",
+				"2024-05-01 Tag der Arbeit",
+				"2024-05-09 Christi Himmelfahrt",
+				"2024-05-20 Pfingstmontag",
+				"2024-10-03 Tag der Deutschen Einheit",
+				"2024-10-31 Reformationstag",
+				"2024-11-20 Buß- und Bettag",
+				"2024-12-25 1. Weihnachtstag",
+				"2024-12-26 2. Weihnachtstag",
+			},
+		},
+		{
+			name:  "Thüringen",
+			state: GermanyTH,
+			year:  2024,
+			want: []string{
+				"2024-01-01 Neujahr",
+				"2024-03-29 Karfreitag",
+				"2024-04-01 Ostermontag",
+				"2024-05-01 Tag der Arbeit",
+				"2024-05-09 Christi Himmelfahrt",
+				"2024-05-20 Pfingstmontag",
+				"2024-09-20 Weltkindertag",
+				"2024-10-03 Tag der Deutschen Einheit",
+				"2024-10-31 Reformationstag",
+				"2024-12-25 1. Weihnachtstag",
+				"2024-12-26 2. Weihnachtstag",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal, err := GermanCalendar(tt.state)
+			if err != nil {
+				t.Fatalf("GermanCalendar(%q) error = %v", tt.state, err)
+			}
+			if got := holidayDates(cal.Holidays(tt.year)); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGermanCalendarHistory(t *testing.T) {
+	reformation := func(year int) LocalDate { return NewLocalDate(year, time.October, 31) }
+
+	tests := []struct {
+		name  string
+		state GermanState
+		date  LocalDate
+		want  bool
+	}{
+		{name: "Reformationstag 2017 nationwide", state: GermanyNW, date: reformation(2017), want: true},
+		{name: "Reformationstag 2018 not in NW", state: GermanyNW, date: reformation(2018), want: false},
+		{name: "Reformationstag 2016 not in HH", state: GermanyHH, date: reformation(2016), want: false},
+		{name: "Reformationstag 2017 in HH", state: GermanyHH, date: reformation(2017), want: true},
+		{name: "Reformationstag 2018 in HH", state: GermanyHH, date: reformation(2018), want: true},
+		{name: "Buß- und Bettag 1994 nationwide", state: GermanyNational, date: NewLocalDate(1994, time.November, 16), want: true},
+		{name: "Buß- und Bettag 1995 abolished", state: GermanyNational, date: NewLocalDate(1995, time.November, 22), want: false},
+		{name: "Tag der Deutschen Einheit 1989", state: GermanyNational, date: NewLocalDate(1989, time.October, 3), want: false},
+		{name: "Frauentag 2018 in Berlin", state: GermanyBE, date: NewLocalDate(2018, time.March, 8), want: false},
+		{name: "Frauentag 2023 in MV", state: GermanyMV, date: NewLocalDate(2023, time.March, 8), want: true},
+		{name: "Mariä Himmelfahrt in SL", state: GermanySL, date: NewLocalDate(2024, time.August, 15), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal, err := GermanCalendar(tt.state)
+			if err != nil {
+				t.Fatalf("GermanCalendar(%q) error = %v", tt.state, err)
+			}
+			if got := cal.IsHoliday(tt.date); got != tt.want {
+				t.Errorf("IsHoliday(%v) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := GermanCalendar("XX"); err == nil {
+		t.Errorf("Expected error for unknown state")
+	}
+}
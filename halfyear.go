@@ -0,0 +1,190 @@
+package localdate
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// HalfYear is a calendar half-year such as 2024-H1, numbered 1 and 2. The zero
+// value is NULL, marshaling to JSON null and SQL NULL. Use NewHalfYear to get
+// a normalized value.
+type HalfYear struct {
+	Year int
+	Half int
+}
+
+// NewHalfYear returns the given half-year, normalizing halves outside [1, 2],
+// so half 3 of 2024 is 2025-H1.
+func NewHalfYear(year, half int) HalfYear {
+	return halfYearFromIndex(int64(year)*2 + int64(half-1))
+}
+
+func halfYearFromIndex(n int64) HalfYear {
+	return HalfYear{Year: int(floorDiv(n, 2)), Half: int(floorMod(n, 2) + 1)}
+}
+
+func (h HalfYear) index() int64 {
+	return int64(h.Year)*2 + int64(h.Half-1)
+}
+
+// HalfYear returns the half-year of d, or the zero HalfYear when d is not
+// finite.
+func (d LocalDate) HalfYear() HalfYear {
+	year, month, _ := d.Date()
+	if month == 0 {
+		return HalfYear{}
+	}
+	return HalfYear{Year: year, Half: int(month-1)/6 + 1}
+}
+
+// IsZero reports whether h is the zero value, i.e. NULL.
+func (h HalfYear) IsZero() bool {
+	return h == HalfYear{}
+}
+
+// FirstMonth returns the first month of h, or the zero YearMonth if h is zero.
+func (h HalfYear) FirstMonth() YearMonth {
+	if h.IsZero() {
+		return YearMonth{}
+	}
+	return YearMonth{Year: h.Year, Month: time.Month((h.Half-1)*6 + 1)}
+}
+
+// FirstDay returns the first day of h, or an invalid date if h is zero.
+func (h HalfYear) FirstDay() LocalDate {
+	return h.FirstMonth().FirstDay()
+}
+
+// LastDay returns the last day of h, or an invalid date if h is zero.
+func (h HalfYear) LastDay() LocalDate {
+	return h.FirstMonth().AddMonths(5).LastDay()
+}
+
+// Days returns the number of days in h, or 0 if h is zero.
+func (h HalfYear) Days() int {
+	if h.IsZero() {
+		return 0
+	}
+	return int(h.LastDay().Days-h.FirstDay().Days) + 1
+}
+
+// Contains reports whether d is a finite date in h.
+func (h HalfYear) Contains(d LocalDate) bool {
+	return !h.IsZero() && d.HalfYear() == h
+}
+
+// Quarters returns the two quarters of h, or zero Quarters if h is zero.
+func (h HalfYear) Quarters() (Quarter, Quarter) {
+	if h.IsZero() {
+		return Quarter{}, Quarter{}
+	}
+	first := Quarter{Year: h.Year, Quarter: h.Half*2 - 1}
+	return first, first.Next()
+}
+
+// Dates returns an iterator over the dates of h in ascending order.
+func (h HalfYear) Dates() iter.Seq[LocalDate] {
+	return unitDates(h)
+}
+
+// AddHalves returns h with halves half-years added. The zero HalfYear is
+// returned unchanged.
+func (h HalfYear) AddHalves(halves int) HalfYear {
+	if h.IsZero() {
+		return h
+	}
+	return NewHalfYear(h.Year, h.Half+halves)
+}
+
+// AddYears returns h with years added. The zero HalfYear is returned
+// unchanged.
+func (h HalfYear) AddYears(years int) HalfYear {
+	return h.AddHalves(years * 2)
+}
+
+// Next returns the half-year after h.
+func (h HalfYear) Next() HalfYear {
+	return h.AddHalves(1)
+}
+
+// Prev returns the half-year before h.
+func (h HalfYear) Prev() HalfYear {
+	return h.AddHalves(-1)
+}
+
+// Compare returns -1, 0 or 1 depending on whether h is before, equal to or
+// after other. The zero HalfYear sorts before all others.
+func (h HalfYear) Compare(other HalfYear) int {
+	return compareUnits(h, other)
+}
+
+// Sub returns the number of half-years from other to h.
+func (h HalfYear) Sub(other HalfYear) int {
+	return int(h.index() - other.index())
+}
+
+// EachHalfYear returns an iterator over the half-years from from to to, both
+// included, iterating backward if to is before from. It returns
+// ErrInvalidDate if either half-year is zero.
+func EachHalfYear(from, to HalfYear) (iter.Seq[HalfYear], error) {
+	return eachUnit(from, to, halfYearFromIndex)
+}
+
+// String returns h in the format 2006-H1, or an empty string if h is zero.
+func (h HalfYear) String() string {
+	b, _ := h.AppendText(make([]byte, 0, 7))
+	return string(b)
+}
+
+// AppendText implements encoding.TextAppender using the same format as String.
+func (h HalfYear) AppendText(b []byte) ([]byte, error) {
+	if h.IsZero() {
+		return b, nil
+	}
+	return appendPartOfYear(b, h.Year, 'H', h.Half), nil
+}
+
+// ParseHalfYear parses a half-year in the format 2006-H1.
+func ParseHalfYear(s string) (HalfYear, error) {
+	year, n, ok := parsePartOfYear(s, 'H', 2)
+	if !ok {
+		return HalfYear{}, fmt.Errorf("invalid half-year %q", s)
+	}
+	return HalfYear{Year: year, Half: n}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the same format as
+// String, so the zero HalfYear marshals to empty text.
+func (h HalfYear) MarshalText() ([]byte, error) {
+	return h.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is the zero
+// HalfYear.
+func (h *HalfYear) UnmarshalText(text []byte) error {
+	return unmarshalUnitText(h, text, ParseHalfYear)
+}
+
+// MarshalJSON encodes the zero HalfYear as null
+func (h HalfYear) MarshalJSON() ([]byte, error) {
+	return marshalUnitJSON(h)
+}
+
+// UnmarshalJSON decodes null as the zero HalfYear
+func (h *HalfYear) UnmarshalJSON(data []byte) error {
+	return unmarshalUnitJSON(h, data, ParseHalfYear)
+}
+
+// SQL scanning. Accepts a half-year in the format 2006-H1 or a date that is
+// the first day of a half-year. NULL scans as the zero HalfYear.
+func (h *HalfYear) Scan(value interface{}) error {
+	return scanUnit(h, value, "HalfYear", ParseHalfYear, LocalDate.HalfYear)
+}
+
+// SQL value. The half-year is stored as its first day, the zero HalfYear as
+// NULL.
+func (h HalfYear) Value() (driver.Value, error) {
+	return h.FirstDay().Value()
+}
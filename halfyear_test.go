@@ -0,0 +1,155 @@
+package localdate
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHalfYear(t *testing.T) {
+	tests := []struct {
+		date      LocalDate
+		want      HalfYear
+		wantFirst LocalDate
+		wantLast  LocalDate
+		wantDays  int
+	}{
+		{NewLocalDate(2024, time.June, 30), HalfYear{2024, 1}, NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.June, 30), 182},
+		{NewLocalDate(2023, time.January, 1), HalfYear{2023, 1}, NewLocalDate(2023, time.January, 1), NewLocalDate(2023, time.June, 30), 181},
+		{NewLocalDate(2023, time.July, 1), HalfYear{2023, 2}, NewLocalDate(2023, time.July, 1), NewLocalDate(2023, time.December, 31), 184},
+		{LocalDate{}, HalfYear{}, LocalDate{}, LocalDate{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			h := tt.date.HalfYear()
+			if h != tt.want {
+				t.Fatalf("HalfYear() = %v, want %v", h, tt.want)
+			}
+			if got := h.FirstDay(); got != tt.wantFirst {
+				t.Errorf("FirstDay() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := h.LastDay(); got != tt.wantLast {
+				t.Errorf("LastDay() = %v, want %v", got, tt.wantLast)
+			}
+			if got := h.Days(); got != tt.wantDays {
+				t.Errorf("Days() = %v, want %v", got, tt.wantDays)
+			}
+			if got := len(slices.Collect(h.Dates())); got != tt.wantDays {
+				t.Errorf("len(Dates()) = %v, want %v", got, tt.wantDays)
+			}
+			if got := h.Contains(tt.date); got != !h.IsZero() {
+				t.Errorf("Contains(%v) = %v", tt.date, got)
+			}
+		})
+	}
+
+	first, second := HalfYear{2024, 2}.Quarters()
+	if first != (Quarter{2024, 3}) || second != (Quarter{2024, 4}) {
+		t.Errorf("Quarters() = %v, %v, want 2024-Q3, 2024-Q4", first, second)
+	}
+}
+
+func TestHalfYearArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  HalfYear
+		want HalfYear
+	}{
+		{name: "normalize", got: NewHalfYear(2024, 3), want: HalfYear{2025, 1}},
+		{name: "normalize negative", got: NewHalfYear(2024, -1), want: HalfYear{2023, 1}},
+		{name: "add", got: HalfYear{2024, 2}.AddHalves(3), want: HalfYear{2026, 1}},
+		{name: "add years", got: HalfYear{2024, 2}.AddYears(-1), want: HalfYear{2023, 2}},
+		{name: "next", got: HalfYear{2024, 2}.Next(), want: HalfYear{2025, 1}},
+		{name: "prev", got: HalfYear{2024, 1}.Prev(), want: HalfYear{2023, 2}},
+		{name: "zero unchanged", got: HalfYear{}.Prev(), want: HalfYear{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := (HalfYear{2025, 1}).Sub(HalfYear{2023, 2}); got != 3 {
+		t.Errorf("Sub() = %v, want 3", got)
+	}
+	if got := (HalfYear{2025, 1}).Compare(HalfYear{2024, 2}); got != 1 {
+		t.Errorf("Compare() = %v, want 1", got)
+	}
+
+	seq, err := EachHalfYear(HalfYear{2024, 2}, HalfYear{2023, 2})
+	if err != nil {
+		t.Fatalf("EachHalfYear() error = %v", err)
+	}
+	want := []HalfYear{{2024, 2}, {2024, 1}, {2023, 2}}
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachHalfYear() = %v, want %v", got, want)
+	}
+}
+
+func TestParseHalfYear(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    HalfYear
+		wantErr bool
+	}{
+		{input: "2024-H1", want: HalfYear{2024, 1}},
+		{input: "2024-H2", want: HalfYear{2024, 2}},
+		{input: "2024-H3", wantErr: true},
+		{input: "2024-Q1", wantErr: true},
+		{input: "2024-H", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseHalfYear(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseHalfYear(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseHalfYear(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.input {
+				t.Errorf("String() = %v, want %v", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestHalfYearCodecs(t *testing.T) {
+	h := HalfYear{2024, 2}
+	got, err := json.Marshal([]HalfYear{h, {}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `["2024-H2",null]`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+	var out []HalfYear
+	if err := json.Unmarshal(got, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !slices.Equal(out, []HalfYear{h, {}}) {
+		t.Errorf("Unmarshal() = %v", out)
+	}
+
+	for _, input := range []interface{}{"2024-H2", "2024-07-01", NewLocalDate(2024, time.July, 1).PgDate()} {
+		var scanned HalfYear
+		if err := scanned.Scan(input); err != nil || scanned != h {
+			t.Errorf("Scan(%v) = %v, %v, want %v", input, scanned, err, h)
+		}
+	}
+	var scanned HalfYear
+	if err := scanned.Scan("2024-04-01"); err == nil {
+		t.Errorf("Expected error scanning a date that does not start a half-year")
+	}
+
+	value, err := h.Value()
+	if err != nil || value != time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, %v, want 2024-07-01", value, err)
+	}
+}
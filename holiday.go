@@ -0,0 +1,262 @@
+package localdate
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// HolidayCalendar answers whether dates are holidays.
+type HolidayCalendar interface {
+	// IsHoliday reports whether d is a holiday.
+	IsHoliday(d LocalDate) bool
+	// HolidayName returns the name of the holiday on d, if any.
+	HolidayName(d LocalDate) (string, bool)
+	// Holidays returns the holidays in the calendar year, sorted by date.
+	Holidays(year int) []Holiday
+}
+
+// Holiday is a named holiday on a date.
+type Holiday struct {
+	Date LocalDate
+	Name string
+	// Substitute is set for a day off given in place of a holiday that falls
+	// on a weekend.
+	Substitute bool
+	// DeFacto is set for a day that is not a public holiday by law but is
+	// commonly a day off, such as Christmas Eve in the Nordic countries.
+	DeFacto bool
+}
+
+// HolidayRule generates holidays. Holidays returns the occurrences of the rule
+// for the given year, which may fall outside that calendar year, e.g. when a
+// substitute day for January 1 is observed on December 31.
+type HolidayRule interface {
+	Holidays(year int) []Holiday
+}
+
+// RuleFunc adapts a function to a HolidayRule.
+type RuleFunc func(year int) []Holiday
+
+// Holidays calls f(year).
+func (f RuleFunc) Holidays(year int) []Holiday {
+	return f(year)
+}
+
+// OneOff returns a rule for a single holiday on d, e.g. for a royal wedding.
+func OneOff(name string, d LocalDate) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		if d.Year() != year {
+			return nil
+		}
+		return []Holiday{{Date: d, Name: name}}
+	})
+}
+
+// FixedDate returns a rule for a holiday on the same day every year. February
+// 29 only occurs in leap years.
+func FixedDate(name string, month time.Month, day int) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		if day < 1 || day > daysInMonth(int64(year), month) {
+			return nil
+		}
+		return []Holiday{{Date: NewLocalDate(year, month, day), Name: name}}
+	})
+}
+
+// NthWeekday returns a rule for a holiday on the n:th wd of the month, see
+// NthWeekdayOfMonth. Years in which the month has no such day have no
+// holiday.
+func NthWeekday(name string, month time.Month, wd time.Weekday, n int) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		d, err := NthWeekdayOfMonth(year, month, wd, n)
+		if err != nil {
+			return nil
+		}
+		return []Holiday{{Date: d, Name: name}}
+	})
+}
+
+// WeekdayOnOrAfter returns a rule for a holiday on the first wd on or after
+// the given day, e.g. Swedish Midsummer Day, the Saturday from June 20.
+func WeekdayOnOrAfter(name string, month time.Month, day int, wd time.Weekday) HolidayRule {
+	return FeastDay(name, WeekdayBetween(wd, month, day))
+}
+
+// EasterOffset returns a rule for a holiday days after Western Easter Sunday,
+// e.g. -2 for Good Friday or 39 for Ascension Day.
+func EasterOffset(name string, days int) HolidayRule {
+	return FeastDay(name, Feast(WesternEaster).Offset(days))
+}
+
+// DeFacto returns rule with all its holidays marked as de facto holidays.
+func DeFacto(rule HolidayRule) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		holidays := rule.Holidays(year)
+		for i := range holidays {
+			holidays[i].DeFacto = true
+		}
+		return holidays
+	})
+}
+
+// ValidDuring returns rule restricted to holidays within r, for rules that were
+// introduced or abolished at some point.
+func ValidDuring(rule HolidayRule, r DateRange) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		return slices.DeleteFunc(rule.Holidays(year), func(h Holiday) bool {
+			return !r.Contains(h.Date)
+		})
+	})
+}
+
+// yearsFrom returns the dates from January 1 of first onward.
+func yearsFrom(first int) DateRange {
+	return NewDateRange(NewLocalDate(first, time.January, 1), InfinityDate())
+}
+
+// yearsUntil returns the dates up to and including December 31 of last.
+func yearsUntil(last int) DateRange {
+	return NewDateRange(NegInfinityDate(), NewLocalDate(last+1, time.January, 1))
+}
+
+// SubstitutePolicy decides which day is given off when a holiday falls on a
+// Saturday or Sunday.
+type SubstitutePolicy int
+
+const (
+	// SubstituteNextWeekday gives the next weekday off, like UK bank holidays.
+	SubstituteNextWeekday SubstitutePolicy = iota
+	// SubstituteNearestWeekday gives the Friday before a Saturday and the
+	// Monday after a Sunday off, like US federal holidays.
+	SubstituteNearestWeekday
+	// SubstituteSundayToMonday only gives the Monday after a Sunday off.
+	SubstituteSundayToMonday
+)
+
+// WithSubstitutes returns a rule generating the holidays of rules together
+// with substitute days for the ones falling on a weekend, chosen according to
+// policy. A substitute day that collides with another holiday or substitute
+// day of rules rolls on to the next free weekday in the same direction, so
+// Christmas Day on a Saturday and Boxing Day on a Sunday give Monday and
+// Tuesday off.
+func WithSubstitutes(policy SubstitutePolicy, rules ...HolidayRule) HolidayRule {
+	return RuleFunc(func(year int) []Holiday {
+		var holidays []Holiday
+		for _, rule := range rules {
+			holidays = append(holidays, rule.Holidays(year)...)
+		}
+		sortHolidays(holidays)
+
+		taken := make(map[LocalDate]bool, len(holidays))
+		for _, h := range holidays {
+			taken[h.Date] = true
+		}
+		var substitutes []Holiday
+		for _, h := range holidays {
+			step := 0
+			switch wd := h.Date.Weekday(); {
+			case wd == time.Sunday:
+				step = 1
+			case wd == time.Saturday && policy == SubstituteNearestWeekday:
+				step = -1
+			case wd == time.Saturday && policy == SubstituteNextWeekday:
+				step = 1
+			}
+			if step == 0 {
+				continue
+			}
+			d := AddDays(h.Date, step)
+			for taken[d] || isWeekend(d) {
+				d = AddDays(d, step)
+			}
+			taken[d] = true
+			substitutes = append(substitutes, Holiday{Date: d, Name: h.Name + " (substitute day)", Substitute: true})
+		}
+		return append(holidays, substitutes...)
+	})
+}
+
+func isWeekend(d LocalDate) bool {
+	wd := d.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// sortHolidays sorts holidays by date, keeping the order of holidays on the
+// same date.
+func sortHolidays(holidays []Holiday) {
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return cmp.Compare(a.Date.Days, b.Date.Days)
+	})
+}
+
+// Calendar is a HolidayCalendar made from holiday rules. The holidays of each
+// year are computed once and cached, and a Calendar is safe for concurrent use.
+type Calendar struct {
+	rules []HolidayRule
+
+	mu    sync.Mutex
+	years map[int][]Holiday
+}
+
+// NewCalendar returns a calendar with the holidays generated by rules.
+func NewCalendar(rules ...HolidayRule) *Calendar {
+	return &Calendar{rules: slices.Clone(rules)}
+}
+
+// holidays returns the cached holidays of the year, which must not be
+// modified.
+func (c *Calendar) holidays(year int) []Holiday {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if holidays, ok := c.years[year]; ok {
+		return holidays
+	}
+
+	var holidays []Holiday
+	for _, rule := range c.rules {
+		// rules may spill into adjacent years, e.g. substitute days
+		for y := year - 1; y <= year+1; y++ {
+			for _, h := range rule.Holidays(y) {
+				if h.Date.Year() == year {
+					holidays = append(holidays, h)
+				}
+			}
+		}
+	}
+	sortHolidays(holidays)
+	if c.years == nil {
+		c.years = make(map[int][]Holiday)
+	}
+	c.years[year] = holidays
+	return holidays
+}
+
+// Holidays returns the holidays in the calendar year, sorted by date. Holidays
+// from different rules on the same date are all included, in rule order.
+func (c *Calendar) Holidays(year int) []Holiday {
+	return slices.Clone(c.holidays(year))
+}
+
+// IsHoliday reports whether d is a holiday. Infinite and invalid dates are not.
+func (c *Calendar) IsHoliday(d LocalDate) bool {
+	_, ok := c.HolidayName(d)
+	return ok
+}
+
+// HolidayName returns the name of the holiday on d. If several holidays fall on
+// d the first one is returned.
+func (c *Calendar) HolidayName(d LocalDate) (string, bool) {
+	if !d.IsFinite() {
+		return "", false
+	}
+	holidays := c.holidays(d.Year())
+	i, found := slices.BinarySearchFunc(holidays, d, func(h Holiday, d LocalDate) int {
+		return cmp.Compare(h.Date.Days, d.Days)
+	})
+	if !found {
+		return "", false
+	}
+	return holidays[i].Name, true
+}
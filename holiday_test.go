@@ -0,0 +1,203 @@
+package localdate
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func holidayDates(holidays []Holiday) []string {
+	var out []string
+	for _, h := range holidays {
+		out = append(out, h.Date.String()+" "+h.Name)
+	}
+	return out
+}
+
+func TestHolidayRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule HolidayRule
+		year int
+		want []string
+	}{
+		{name: "fixed date", rule: FixedDate("Christmas Day", time.December, 25), year: 2024, want: []string{"2024-12-25 Christmas Day"}},
+		{name: "leap day in leap year", rule: FixedDate("Leap Day", time.February, 29), year: 2024, want: []string{"2024-02-29 Leap Day"}},
+		{name: "leap day in common year", rule: FixedDate("Leap Day", time.February, 29), year: 2023},
+		{name: "nth weekday", rule: NthWeekday("Thanksgiving", time.November, time.Thursday, 4), year: 2024, want: []string{"2024-11-28 Thanksgiving"}},
+		{name: "last weekday", rule: NthWeekday("Memorial Day", time.May, time.Monday, -1), year: 2024, want: []string{"2024-05-27 Memorial Day"}},
+		{name: "missing nth weekday", rule: NthWeekday("Fifth Monday", time.May, time.Monday, 5), year: 2024},
+		{name: "weekday on or after", rule: WeekdayOnOrAfter("Midsommardagen", time.June, 20, time.Saturday), year: 2024, want: []string{"2024-06-22 Midsommardagen"}},
+		{name: "easter", rule: EasterOffset("Easter Sunday", 0), year: 2024, want: []string{"2024-03-31 Easter Sunday"}},
+		{name: "good friday", rule: EasterOffset("Good Friday", -2), year: 2025, want: []string{"2025-04-18 Good Friday"}},
+		{name: "ascension", rule: EasterOffset("Ascension Day", 39), year: 2024, want: []string{"2024-05-09 Ascension Day"}},
+		{name: "one-off", rule: OneOff("Coronation", NewLocalDate(2023, time.May, 8)), year: 2023, want: []string{"2023-05-08 Coronation"}},
+		{name: "one-off other year", rule: OneOff("Coronation", NewLocalDate(2023, time.May, 8)), year: 2024},
+		{
+			name: "valid during",
+			rule: ValidDuring(FixedDate("National Day", time.June, 6), NewDateRange(NewLocalDate(2005, time.January, 1), InfinityDate())),
+			year: 2005,
+			want: []string{"2005-06-06 National Day"},
+		},
+		{
+			name: "not valid before",
+			rule: ValidDuring(FixedDate("National Day", time.June, 6), NewDateRange(NewLocalDate(2005, time.January, 1), InfinityDate())),
+			year: 2004,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := holidayDates(tt.rule.Holidays(tt.year)); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithSubstitutes(t *testing.T) {
+	christmas := []HolidayRule{
+		FixedDate("Christmas Day", time.December, 25),
+		FixedDate("Boxing Day", time.December, 26),
+	}
+
+	tests := []struct {
+		name   string
+		policy SubstitutePolicy
+		rules  []HolidayRule
+		year   int
+		want   []string
+	}{
+		{
+			name:   "weekdays need no substitutes",
+			policy: SubstituteNextWeekday,
+			rules:  christmas,
+			year:   2024,
+			want:   []string{"2024-12-25 Christmas Day", "2024-12-26 Boxing Day"},
+		},
+		{
+			name:   "saturday and sunday roll over each other",
+			policy: SubstituteNextWeekday,
+			rules:  christmas,
+			year:   2021,
+			want: []string{
+				"2021-12-25 Christmas Day", "2021-12-26 Boxing Day",
+				"2021-12-27 Christmas Day (substitute day)", "2021-12-28 Boxing Day (substitute day)",
+			},
+		},
+		{
+			name:   "sunday and monday",
+			policy: SubstituteNextWeekday,
+			rules:  christmas,
+			year:   2022,
+			want: []string{
+				"2022-12-25 Christmas Day", "2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (substitute day)",
+			},
+		},
+		{
+			name:   "nearest weekday",
+			policy: SubstituteNearestWeekday,
+			rules:  []HolidayRule{FixedDate("Independence Day", time.July, 4)},
+			year:   2026,
+			want:   []string{"2026-07-04 Independence Day", "2026-07-03 Independence Day (substitute day)"},
+		},
+		{
+			name:   "nearest weekday into previous year",
+			policy: SubstituteNearestWeekday,
+			rules:  []HolidayRule{FixedDate("New Year's Day", time.January, 1)},
+			year:   2022,
+			want:   []string{"2022-01-01 New Year's Day", "2021-12-31 New Year's Day (substitute day)"},
+		},
+		{
+			name:   "sunday to monday ignores saturday",
+			policy: SubstituteSundayToMonday,
+			rules:  christmas,
+			year:   2021,
+			want:   []string{"2021-12-25 Christmas Day", "2021-12-26 Boxing Day", "2021-12-27 Boxing Day (substitute day)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays := WithSubstitutes(tt.policy, tt.rules...).Holidays(tt.year)
+			if got := holidayDates(holidays); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+			for _, h := range holidays {
+				if h.Substitute != strings.HasSuffix(h.Name, "(substitute day)") {
+					t.Errorf("Substitute = %v for %v", h.Substitute, h)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	cal := NewCalendar(
+		FixedDate("New Year's Day", time.January, 1),
+		EasterOffset("Good Friday", -2),
+		FixedDate("Christmas Eve", time.December, 24),
+		WithSubstitutes(SubstituteNearestWeekday, FixedDate("New Year's Day", time.January, 1)),
+	)
+
+	var _ HolidayCalendar = cal
+
+	got := holidayDates(cal.Holidays(2021))
+	want := []string{
+		"2021-01-01 New Year's Day",
+		"2021-01-01 New Year's Day",
+		"2021-04-02 Good Friday",
+		"2021-12-24 Christmas Eve",
+		"2021-12-31 New Year's Day (substitute day)",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Holidays(2021) = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		date     LocalDate
+		wantName string
+		wantOK   bool
+	}{
+		{date: NewLocalDate(2021, time.April, 2), wantName: "Good Friday", wantOK: true},
+		{date: NewLocalDate(2021, time.December, 31), wantName: "New Year's Day (substitute day)", wantOK: true},
+		{date: NewLocalDate(2021, time.December, 30)},
+		{date: InfinityDate()},
+		{date: LocalDate{}},
+	}
+	for _, tt := range tests {
+		name, ok := cal.HolidayName(tt.date)
+		if name != tt.wantName || ok != tt.wantOK {
+			t.Errorf("HolidayName(%v) = %q, %v, want %q, %v", tt.date, name, ok, tt.wantName, tt.wantOK)
+		}
+		if got := cal.IsHoliday(tt.date); got != tt.wantOK {
+			t.Errorf("IsHoliday(%v) = %v, want %v", tt.date, got, tt.wantOK)
+		}
+	}
+
+	holidays := cal.Holidays(2021)
+	holidays[0].Name = "modified"
+	if name, _ := cal.HolidayName(NewLocalDate(2021, time.January, 1)); name != "New Year's Day" {
+		t.Errorf("Holidays() result shares the cache")
+	}
+}
+
+func TestCalendarConcurrent(t *testing.T) {
+	cal := NewCalendar(EasterOffset("Easter Monday", 1))
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for year := 2000; year < 2050; year++ {
+				if len(cal.Holidays(year+i%2)) != 1 {
+					t.Errorf("Holidays(%d) has wrong length", year)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"github.com/jackc/pgx/v5/pgtype"
 	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -17,6 +19,9 @@ type LocalDate struct {
 const (
 	daysInfinity    = math.MaxInt32
 	daysNegInfinity = math.MinInt32
+
+	infinityLiteral    = "infinity"
+	negInfinityLiteral = "-infinity"
 )
 
 func NewLocalDate(year int, month time.Month, day int) LocalDate {
@@ -40,6 +45,66 @@ func (d LocalDate) Time() time.Time {
 	return time.Unix(int64(d.Days)*86400, 0).UTC()
 }
 
+// Date returns the year, month and day of d. It returns zero values when d is
+// not finite, i.e. when it is invalid, infinity or negative infinity.
+func (d LocalDate) Date() (year int, month time.Month, day int) {
+	if !d.IsFinite() {
+		return 0, 0, 0
+	}
+	return civilFromDays(int64(d.Days))
+}
+
+// Year returns the year of d, or 0 when d is not finite.
+func (d LocalDate) Year() int {
+	year, _, _ := d.Date()
+	return year
+}
+
+// Month returns the month of d, or 0 when d is not finite.
+func (d LocalDate) Month() time.Month {
+	_, month, _ := d.Date()
+	return month
+}
+
+// Day returns the day of the month of d, or 0 when d is not finite.
+func (d LocalDate) Day() int {
+	_, _, day := d.Date()
+	return day
+}
+
+// Weekday returns the day of the week of d. Since time.Weekday has no zero
+// "unknown" value, callers should check IsFinite first; a non-finite d
+// returns time.Sunday.
+func (d LocalDate) Weekday() time.Weekday {
+	if !d.IsFinite() {
+		return time.Sunday
+	}
+	return weekdayOf(int64(d.Days))
+}
+
+// weekdayOf returns the day of the week of a date given in days since
+// 1970-01-01.
+func weekdayOf(days int64) time.Weekday {
+	// 1970-01-01 was a Thursday
+	return time.Weekday(floorMod(days+4, 7))
+}
+
+// YearDay returns the day of the year of d in the range [1,365] for non-leap
+// years and [1,366] in leap years, or 0 when d is not finite.
+func (d LocalDate) YearDay() int {
+	if !d.IsFinite() {
+		return 0
+	}
+	year, _, _ := civilFromDays(int64(d.Days))
+	return int(int64(d.Days)-daysFromCivil(int64(year), time.January, 1)) + 1
+}
+
+// IsFinite reports whether d is a valid date that is neither infinity nor
+// negative infinity.
+func (d LocalDate) IsFinite() bool {
+	return d.Valid && !d.IsInfinity() && !d.IsNegInfinity()
+}
+
 func (d LocalDate) IsInfinity() bool {
 	return d.Days == daysInfinity
 }
@@ -60,75 +125,92 @@ func (d LocalDate) InfinityModifier() int32 {
 	}
 }
 
+// MarshalJSON encodes an invalid date as null
 func (d LocalDate) MarshalJSON() ([]byte, error) {
-	if d.IsInfinity() {
-		return []byte(`"infinity"`), nil
+	if !d.Valid {
+		return []byte("null"), nil
 	}
-	if d.IsNegInfinity() {
-		return []byte(`"-infinity"`), nil
+	b := append(make([]byte, 0, 12), '"')
+	b, err := d.AppendText(b)
+	if err != nil {
+		return nil, err
 	}
-	return json.Marshal(d.Time().Format("2006-01-02"))
+	return append(b, '"'), nil
 }
 
+// UnmarshalJSON decodes null as an invalid date
 func (d *LocalDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = LocalDate{}
+		return nil
+	}
+
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
-
-	switch s {
-	case "infinity":
-		d.Days = daysInfinity
-		return nil
-	case "-infinity":
-		d.Days = daysNegInfinity
-		return nil
-	default:
-		t, err := time.Parse("2006-01-02", s)
-		if err != nil {
-			return err
-		}
-		*d = NewLocalDate(t.Year(), t.Month(), t.Day())
-		return nil
+	parsed, err := parseLocalDate(s)
+	if err != nil {
+		return err
 	}
+	*d = parsed
+	return nil
 }
 
-// SQL scanning
+// IsZero reports whether d is invalid, i.e. NULL. It makes the omitzero JSON
+// option omit invalid dates.
+func (d LocalDate) IsZero() bool {
+	return !d.Valid
+}
+
+// SQL scanning. NULL scans as an invalid date.
 func (d *LocalDate) Scan(value interface{}) error {
 	switch v := value.(type) {
 	case time.Time:
 		*d = NewLocalDate(v.Year(), v.Month(), v.Day())
 		return nil
-	case string:
-		switch v {
-		case "infinity":
-			d.Days = daysInfinity
-			return nil
-		case "-infinity":
-			d.Days = daysNegInfinity
-			return nil
-		default:
-			t, err := time.Parse("2006-01-02", v)
-			if err != nil {
-				return err
-			}
-			*d = NewLocalDate(t.Year(), t.Month(), t.Day())
+	case *time.Time:
+		if v == nil {
+			*d = LocalDate{}
 			return nil
 		}
+		return d.Scan(*v)
+	case string:
+		parsed, err := parseLocalDate(v)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+	case []byte:
+		return d.Scan(string(v))
+	case int64:
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return fmt.Errorf("unsupported Scan, %d epoch days is out of range for LocalDate", v)
+		}
+		*d = LocalDate{Days: int32(v), Valid: true}
+		return nil
+	case pgtype.Date:
+		*d = FromPgDate(v)
+		return nil
 	case nil:
+		*d = LocalDate{}
 		return nil
 	default:
 		return fmt.Errorf("unsupported Scan, storing %T into LocalDate", value)
 	}
 }
 
-// SQL value
+// SQL value. An invalid date is NULL.
 func (d LocalDate) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
 	if d.IsInfinity() {
-		return "infinity", nil
+		return infinityLiteral, nil
 	}
 	if d.IsNegInfinity() {
-		return "-infinity", nil
+		return negInfinityLiteral, nil
 	}
 	return d.Time(), nil
 }
@@ -151,6 +233,21 @@ func (d LocalDate) PgDate() pgtype.Date {
 	}
 }
 
+// FromPgDate converts a pgtype date to a LocalDate. A NULL date becomes an
+// invalid LocalDate.
+func FromPgDate(v pgtype.Date) LocalDate {
+	switch {
+	case !v.Valid:
+		return LocalDate{}
+	case v.InfinityModifier == pgtype.Infinity:
+		return InfinityDate()
+	case v.InfinityModifier == pgtype.NegativeInfinity:
+		return NegInfinityDate()
+	default:
+		return NewLocalDate(v.Time.Year(), v.Time.Month(), v.Time.Day())
+	}
+}
+
 func Today() LocalDate {
 	now := time.Now().UTC()
 	return NewLocalDate(now.Year(), now.Month(), now.Day())
@@ -164,18 +261,56 @@ func At(at string) (LocalDate, error) {
 	return NewLocalDate(t.Year(), t.Month(), t.Day()), nil
 }
 
+// parseLocalDate parses a date in the format 2006-01-02 or one of the
+// literals infinity and -infinity. Years outside [0, 9999] are accepted in the
+// form AppendText writes them, e.g. 10000-01-01 or -0001-12-31.
+func parseLocalDate(s string) (LocalDate, error) {
+	switch {
+	case s == infinityLiteral:
+		return InfinityDate(), nil
+	case s == negInfinityLiteral:
+		return NegInfinityDate(), nil
+	case len(s) > 10 || strings.HasPrefix(s, "-"):
+		return parseExtendedDate(s)
+	default:
+		return At(s)
+	}
+}
+
+// parseExtendedDate parses a date whose year has more than four digits or a
+// minus sign.
+func parseExtendedDate(s string) (LocalDate, error) {
+	i := len(s) - 6
+	if i <= 0 || s[i] != '-' || s[i+3] != '-' {
+		return LocalDate{}, fmt.Errorf("invalid date %q", s)
+	}
+	year, ok := parseYear(s[:i])
+	month, errMonth := strconv.Atoi(s[i+1 : i+3])
+	day, errDay := strconv.Atoi(s[i+4:])
+	if !ok || errMonth != nil || errDay != nil || s[i+1] == '+' || s[i+4] == '+' ||
+		month < 1 || month > 12 || day < 1 || day > daysInMonth(int64(year), time.Month(month)) {
+		return LocalDate{}, fmt.Errorf("invalid date %q", s)
+	}
+	d, err := fromDays(daysFromCivil(int64(year), time.Month(month), day))
+	if err != nil {
+		return LocalDate{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return d, nil
+}
+
 func ToLocalDate(t time.Time) LocalDate {
 	return NewLocalDate(t.Year(), t.Month(), t.Day())
 }
 
+// IsEqual, IsAfter, IsBefore and IsBetween use the ordering of Compare
 func IsEqual(a, b LocalDate) bool {
-	return a == b
+	return a.Compare(b) == 0
 }
 func IsAfter(a, b LocalDate) bool {
-	return a.Days > b.Days
+	return a.Compare(b) > 0
 }
 func IsBefore(a, b LocalDate) bool {
-	return a.Days < b.Days
+	return a.Compare(b) < 0
 }
 func AddDays(a LocalDate, n int) LocalDate {
 	if a.IsInfinity() || a.IsNegInfinity() {
@@ -195,5 +330,70 @@ func (t LocalDate) AddDate(years int, months int, days int) LocalDate {
 }
 
 func IsBetween(needle, from, to LocalDate) bool {
-	return needle.Days >= from.Days && needle.Days <= to.Days
+	return needle.Compare(from) >= 0 && needle.Compare(to) <= 0
+}
+
+// civilFromDays converts days since 1970-01-01 to a proleptic Gregorian date.
+// See http://howardhinnant.github.io/date_algorithms.html#civil_from_days
+func civilFromDays(days int64) (year int, month time.Month, day int) {
+	z := days + 719468
+	era := floorDiv(z, 146097)
+	doe := z - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11], March based
+	d := doy - (153*mp+2)/5 + 1                            // [1, 31]
+	m := mp + 3
+	if m > 12 {
+		m -= 12
+	}
+	y := yoe + era*400
+	if m <= 2 {
+		y++
+	}
+	return int(y), time.Month(m), int(d)
+}
+
+// daysFromCivil converts a proleptic Gregorian date to days since 1970-01-01.
+// The month and day must already be normalized.
+// See http://howardhinnant.github.io/date_algorithms.html#days_from_civil
+func daysFromCivil(year int64, month time.Month, day int) int64 {
+	if month <= 2 {
+		year--
+	}
+	era := floorDiv(year, 400)
+	yoe := year - era*400                                   // [0, 399]
+	doy := (153*((int64(month)+9)%12)+2)/5 + int64(day) - 1 // [0, 365]
+	doe := yoe*365 + yoe/4 - yoe/100 + doy                  // [0, 146096]
+	return era*146097 + doe - 719468
+}
+
+func isLeapYear(year int64) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func daysInMonth(year int64, month time.Month) int {
+	switch month {
+	case time.February:
+		if isLeapYear(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int64) int64 {
+	return a - floorDiv(a, b)*b
 }
@@ -3,6 +3,8 @@ package localdate
 import (
 	"testing"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func TestToday(t *testing.T) {
@@ -653,3 +655,278 @@ func TestEdgeCases(t *testing.T) {
 		}
 	})
 }
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      LocalDate
+		wantYear  int
+		wantMonth time.Month
+		wantDay   int
+		wantWd    time.Weekday
+		wantYd    int
+	}{
+		{
+			name:      "unix epoch",
+			date:      NewLocalDate(1970, time.January, 1),
+			wantYear:  1970,
+			wantMonth: time.January,
+			wantDay:   1,
+			wantWd:    time.Thursday,
+			wantYd:    1,
+		},
+		{
+			name:      "day before epoch",
+			date:      NewLocalDate(1969, time.December, 31),
+			wantYear:  1969,
+			wantMonth: time.December,
+			wantDay:   31,
+			wantWd:    time.Wednesday,
+			wantYd:    365,
+		},
+		{
+			name:      "leap day",
+			date:      NewLocalDate(2024, time.February, 29),
+			wantYear:  2024,
+			wantMonth: time.February,
+			wantDay:   29,
+			wantWd:    time.Thursday,
+			wantYd:    60,
+		},
+		{
+			name:      "end of leap year",
+			date:      NewLocalDate(2000, time.December, 31),
+			wantYear:  2000,
+			wantMonth: time.December,
+			wantDay:   31,
+			wantWd:    time.Sunday,
+			wantYd:    366,
+		},
+		{
+			name:      "min date",
+			date:      NewLocalDate(1, time.January, 1),
+			wantYear:  1,
+			wantMonth: time.January,
+			wantDay:   1,
+			wantWd:    time.Monday,
+			wantYd:    1,
+		},
+		{
+			name:      "max date",
+			date:      NewLocalDate(9999, time.December, 31),
+			wantYear:  9999,
+			wantMonth: time.December,
+			wantDay:   31,
+			wantWd:    time.Friday,
+			wantYd:    365,
+		},
+		{
+			name:   "infinity",
+			date:   InfinityDate(),
+			wantWd: time.Sunday,
+		},
+		{
+			name:   "negative infinity",
+			date:   NegInfinityDate(),
+			wantWd: time.Sunday,
+		},
+		{
+			name:   "invalid",
+			date:   LocalDate{Days: 19492},
+			wantWd: time.Sunday,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, month, day := tt.date.Date()
+			if year != tt.wantYear || month != tt.wantMonth || day != tt.wantDay {
+				t.Errorf("Date() = %d, %v, %d, want %d, %v, %d", year, month, day, tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+			if got := tt.date.Year(); got != tt.wantYear {
+				t.Errorf("Year() = %v, want %v", got, tt.wantYear)
+			}
+			if got := tt.date.Month(); got != tt.wantMonth {
+				t.Errorf("Month() = %v, want %v", got, tt.wantMonth)
+			}
+			if got := tt.date.Day(); got != tt.wantDay {
+				t.Errorf("Day() = %v, want %v", got, tt.wantDay)
+			}
+			if got := tt.date.Weekday(); got != tt.wantWd {
+				t.Errorf("Weekday() = %v, want %v", got, tt.wantWd)
+			}
+			if got := tt.date.YearDay(); got != tt.wantYd {
+				t.Errorf("YearDay() = %v, want %v", got, tt.wantYd)
+			}
+		})
+	}
+}
+
+func TestDateMatchesTime(t *testing.T) {
+	from := NewLocalDate(1, time.January, 1)
+	to := NewLocalDate(9999, time.December, 31)
+	for days := from.Days; days <= to.Days; days++ {
+		d := LocalDate{Days: days, Valid: true}
+		tm := d.Time()
+		year, month, day := d.Date()
+		wantYear, wantMonth, wantDay := tm.Date()
+		if year != wantYear || month != wantMonth || day != wantDay {
+			t.Fatalf("Date() of %d = %d-%d-%d, want %d-%d-%d", days, year, month, day, wantYear, wantMonth, wantDay)
+		}
+		if got := d.Weekday(); got != tm.Weekday() {
+			t.Fatalf("Weekday() of %d = %v, want %v", days, got, tm.Weekday())
+		}
+		if got := d.YearDay(); got != tm.YearDay() {
+			t.Fatalf("YearDay() of %d = %v, want %v", days, got, tm.YearDay())
+		}
+		if got := daysFromCivil(int64(year), month, day); got != int64(days) {
+			t.Fatalf("daysFromCivil(%d, %d, %d) = %d, want %d", year, month, day, got, days)
+		}
+	}
+}
+
+func TestIsFinite(t *testing.T) {
+	tests := []struct {
+		name string
+		date LocalDate
+		want bool
+	}{
+		{name: "finite", date: NewLocalDate(2023, time.May, 15), want: true},
+		{name: "infinity", date: InfinityDate(), want: false},
+		{name: "negative infinity", date: NegInfinityDate(), want: false},
+		{name: "invalid", date: LocalDate{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.IsFinite(); got != tt.want {
+				t.Errorf("IsFinite() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	tm := time.Date(2023, time.May, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    LocalDate
+		wantErr bool
+	}{
+		{
+			name:  "time",
+			value: tm,
+			want:  NewLocalDate(2023, time.May, 15),
+		},
+		{
+			name:  "time pointer",
+			value: &tm,
+			want:  NewLocalDate(2023, time.May, 15),
+		},
+		{
+			name:  "nil time pointer",
+			value: (*time.Time)(nil),
+			want:  LocalDate{},
+		},
+		{
+			name:  "string",
+			value: "2023-05-15",
+			want:  NewLocalDate(2023, time.May, 15),
+		},
+		{
+			name:  "bytes",
+			value: []byte("2023-05-15"),
+			want:  NewLocalDate(2023, time.May, 15),
+		},
+		{
+			name:  "infinity string",
+			value: "infinity",
+			want:  InfinityDate(),
+		},
+		{
+			name:  "negative infinity bytes",
+			value: []byte("-infinity"),
+			want:  NegInfinityDate(),
+		},
+		{
+			name:  "epoch days",
+			value: int64(19492),
+			want:  NewLocalDate(2023, time.May, 15),
+		},
+		{
+			name:  "negative epoch days",
+			value: int64(-1),
+			want:  NewLocalDate(1969, time.December, 31),
+		},
+		{
+			name:    "epoch days out of range",
+			value:   int64(1) << 40,
+			wantErr: true,
+		},
+		{
+			name:  "pgtype date",
+			value: pgtype.Date{Time: tm, Valid: true},
+			want:  NewLocalDate(2023, time.May, 15),
+		},
+		{
+			name:  "pgtype infinity",
+			value: pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true},
+			want:  InfinityDate(),
+		},
+		{
+			name:  "pgtype null",
+			value: pgtype.Date{},
+			want:  LocalDate{},
+		},
+		{
+			name:  "nil",
+			value: nil,
+			want:  LocalDate{},
+		},
+		{
+			name:    "invalid string",
+			value:   "15/05/2023",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			value:   3.14,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewLocalDate(2000, time.January, 1)
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromPgDate(t *testing.T) {
+	tests := []struct {
+		name string
+		date LocalDate
+	}{
+		{name: "regular date", date: NewLocalDate(2023, time.May, 15)},
+		{name: "infinity", date: InfinityDate()},
+		{name: "negative infinity", date: NegInfinityDate()},
+		{name: "invalid", date: LocalDate{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromPgDate(tt.date.PgDate()); got != tt.date {
+				t.Errorf("FromPgDate(%v.PgDate()) = %v, want %v", tt.date, got, tt.date)
+			}
+		})
+	}
+}
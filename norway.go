@@ -0,0 +1,32 @@
+package localdate
+
+import "time"
+
+// NorwegianCalendar returns the Norwegian public holidays (helligdager og
+// høytidsdager). Sundays are left to the weekend handling of the caller.
+//
+// If includeDeFacto is set the calendar also contains Julaften and
+// Nyttårsaften, which are commonly days off, with Holiday.DeFacto set.
+func NorwegianCalendar(includeDeFacto bool) *Calendar {
+	rules := []HolidayRule{
+		FixedDate("Nyttårsdag", time.January, 1),
+		EasterOffset("Skjærtorsdag", -3),
+		EasterOffset("Langfredag", -2),
+		EasterOffset("Første påskedag", 0),
+		EasterOffset("Andre påskedag", 1),
+		ValidDuring(FixedDate("Arbeidernes dag", time.May, 1), yearsFrom(1947)),
+		ValidDuring(FixedDate("Grunnlovsdag", time.May, 17), yearsFrom(1947)),
+		EasterOffset("Kristi himmelfartsdag", 39),
+		EasterOffset("Første pinsedag", 49),
+		EasterOffset("Andre pinsedag", 50),
+		FixedDate("Første juledag", time.December, 25),
+		FixedDate("Andre juledag", time.December, 26),
+	}
+	if includeDeFacto {
+		rules = append(rules,
+			DeFacto(FixedDate("Julaften", time.December, 24)),
+			DeFacto(FixedDate("Nyttårsaften", time.December, 31)),
+		)
+	}
+	return NewCalendar(rules...)
+}
@@ -0,0 +1,63 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNorwegianCalendar(t *testing.T) {
+	tests := []struct {
+		name    string
+		defacto bool
+		year    int
+		want    []string
+	}{
+		{
+			name: "2024",
+			year: 2024,
+			want: []string{
+				"2024-01-01 Nyttårsdag",
+				"2024-03-28 Skjærtorsdag",
+				"2024-03-29 Langfredag",
+				"2024-03-31 Første påskedag",
+				"2024-04-01 Andre påskedag",
+				"2024-05-01 Arbeidernes dag",
+				"2024-05-09 Kristi himmelfartsdag",
+				"2024-05-17 Grunnlovsdag",
+				"2024-05-19 Første pinsedag",
+				"2024-05-20 Andre pinsedag",
+				"2024-12-25 Første juledag",
+				"2024-12-26 Andre juledag",
+			},
+		},
+		{
+			name:    "2025 with de facto holidays",
+			defacto: true,
+			year:    2025,
+			want: []string{
+				"2025-01-01 Nyttårsdag",
+				"2025-04-17 Skjærtorsdag",
+				"2025-04-18 Langfredag",
+				"2025-04-20 Første påskedag",
+				"2025-04-21 Andre påskedag",
+				"2025-05-01 Arbeidernes dag",
+				"2025-05-17 Grunnlovsdag",
+				"2025-05-29 Kristi himmelfartsdag",
+				"2025-06-08 Første pinsedag",
+				"2025-06-09 Andre pinsedag",
+				"2025-12-24 Julaften",
+				"2025-12-25 Første juledag",
+				"2025-12-26 Andre juledag",
+				"2025-12-31 Nyttårsaften",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := holidayDates(NorwegianCalendar(tt.defacto).Holidays(tt.year)); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package localdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// These tests pin how an invalid (NULL) LocalDate is represented by every codec
+// in the package, and that a valid date never turns into NULL on the way.
+
+func TestNullJSON(t *testing.T) {
+	got, err := json.Marshal(LocalDate{})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(got) != "null" {
+		t.Errorf("Marshal(invalid) = %s, want null", got)
+	}
+
+	d := NewLocalDate(2023, time.May, 15)
+	if err := json.Unmarshal([]byte("null"), &d); err != nil {
+		t.Fatalf("Unmarshal(null) error = %v", err)
+	}
+	if d != (LocalDate{}) {
+		t.Errorf("Unmarshal(null) = %v, want invalid", d)
+	}
+
+	for _, tt := range []struct {
+		input string
+		want  LocalDate
+	}{
+		{input: `"2023-05-15"`, want: NewLocalDate(2023, time.May, 15)},
+		{input: `"infinity"`, want: InfinityDate()},
+		{input: `"-infinity"`, want: NegInfinityDate()},
+	} {
+		var got LocalDate
+		if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNullJSONOmitZero(t *testing.T) {
+	type row struct {
+		From LocalDate `json:"from,omitzero"`
+		To   LocalDate `json:"to"`
+	}
+
+	tests := []struct {
+		name string
+		row  row
+		want string
+	}{
+		{
+			name: "invalid dates",
+			row:  row{},
+			want: `{"to":null}`,
+		},
+		{
+			name: "valid dates",
+			row:  row{From: NewLocalDate(2023, time.May, 15), To: InfinityDate()},
+			want: `{"from":"2023-05-15","to":"infinity"}`,
+		},
+		{
+			name: "epoch is not zero",
+			row:  row{From: NewLocalDate(1970, time.January, 1)},
+			want: `{"from":"1970-01-01","to":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.row)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+
+			var back row
+			if err := json.Unmarshal(got, &back); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if back != tt.row {
+				t.Errorf("Unmarshal() = %v, want %v", back, tt.row)
+			}
+		})
+	}
+}
+
+func TestNullSQL(t *testing.T) {
+	value, err := LocalDate{}.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != nil {
+		t.Errorf("Value(invalid) = %v, want nil", value)
+	}
+
+	d := NewLocalDate(2023, time.May, 15)
+	if err := d.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil) error = %v", err)
+	}
+	if d != (LocalDate{}) {
+		t.Errorf("Scan(nil) = %v, want invalid", d)
+	}
+
+	for _, date := range []LocalDate{NewLocalDate(1970, time.January, 1), InfinityDate(), NegInfinityDate()} {
+		value, err := date.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+		var got LocalDate
+		if err := got.Scan(value); err != nil {
+			t.Fatalf("Scan(%v) error = %v", value, err)
+		}
+		if got != date {
+			t.Errorf("Scan(Value(%v)) = %v, want %v", date, got, date)
+		}
+	}
+}
+
+func TestNullPgtype(t *testing.T) {
+	if got := (LocalDate{}).PgDate(); got.Valid {
+		t.Errorf("PgDate(invalid) = %+v, want NULL", got)
+	}
+	if got := FromPgDate(pgtype.Date{}); got != (LocalDate{}) {
+		t.Errorf("FromPgDate(NULL) = %v, want invalid", got)
+	}
+
+	m := pgtype.NewMap()
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.DateOID, format, LocalDate{}, nil)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		if buf != nil {
+			t.Errorf("Encode(invalid) = %v, want NULL", buf)
+		}
+		got := NewLocalDate(2023, time.May, 15)
+		if err := m.Scan(pgtype.DateOID, format, nil, &got); err != nil {
+			t.Fatalf("Scan(NULL) error = %v", err)
+		}
+		if got != (LocalDate{}) {
+			t.Errorf("Scan(NULL) = %v, want invalid", got)
+		}
+	}
+}
+
+func TestNullRanges(t *testing.T) {
+	value, err := DateRange{}.Value()
+	if err != nil || value != nil {
+		t.Errorf("DateRange{}.Value() = %v, %v, want nil", value, err)
+	}
+	if got := (DateRange{}).PgRange(); got.Valid {
+		t.Errorf("DateRange{}.PgRange() = %+v, want NULL", got)
+	}
+	r := NewDateRange(NewLocalDate(2023, time.May, 15), InfinityDate())
+	if err := r.Scan(nil); err != nil || r.Valid {
+		t.Errorf("DateRange.Scan(nil) = %v, %v, want invalid", r, err)
+	}
+
+	// DateRangeSet has no NULL representation, NULL scans as an empty set
+	s := NewDateRangeSet(NewDateRange(NewLocalDate(2023, time.May, 15), InfinityDate()))
+	if err := s.Scan(nil); err != nil || !s.IsEmpty() {
+		t.Errorf("DateRangeSet.Scan(nil) = %v, %v, want empty", s, err)
+	}
+}
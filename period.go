@@ -0,0 +1,284 @@
+package localdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Period is an amount of calendar time in years, months and days, modeled on
+// ISO 8601 date durations such as P1Y2M10D. Unlike time.Duration the length of
+// a Period depends on the date it is added to.
+//
+// The components may have different signs and are not normalized unless
+// Normalized is called.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+func NewPeriod(years, months, days int) Period {
+	return Period{Years: years, Months: months, Days: days}
+}
+
+// IsZero reports whether all components of p are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Normalized returns p with whole years moved out of the months, so that
+// P1Y14M becomes P2Y2M and P1Y-2M becomes P10M. Days are left as is since the
+// number of days in a month varies.
+func (p Period) Normalized() Period {
+	total := p.TotalMonths()
+	return Period{Years: total / 12, Months: total % 12, Days: p.Days}
+}
+
+// TotalMonths returns the years and months of p expressed in months.
+func (p Period) TotalMonths() int {
+	return p.Years*12 + p.Months
+}
+
+// Negated returns p with every component negated.
+func (p Period) Negated() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// Plus returns the component-wise sum of p and other.
+func (p Period) Plus(other Period) Period {
+	return Period{Years: p.Years + other.Years, Months: p.Months + other.Months, Days: p.Days + other.Days}
+}
+
+// Minus returns the component-wise difference of p and other.
+func (p Period) Minus(other Period) Period {
+	return p.Plus(other.Negated())
+}
+
+// Plus returns d with p added using AddDate.
+func (d LocalDate) Plus(p Period) LocalDate {
+	return d.AddDate(p.Years, p.Months, p.Days)
+}
+
+// Minus returns d with p subtracted using AddDate.
+func (d LocalDate) Minus(p Period) LocalDate {
+	return d.AddDate(-p.Years, -p.Months, -p.Days)
+}
+
+// Between returns the calendar difference from a to b in years, months and
+// days. The result is normalized, all components have the same sign, and
+// months are counted the way AddDate counts them, so a.Plus(Between(a, b))
+// always equals b. If a or b is not finite the zero Period is returned.
+//
+// Because of that, days of month past 28 can make Between one month closer to
+// zero than MonthsBetween, which counts months like PostgreSQL's age(). From
+// 2023-01-31 to 2023-03-01 Between is P29D but MonthsBetween is 1.
+func Between(a, b LocalDate) Period {
+	if !a.IsFinite() || !b.IsFinite() {
+		return Period{}
+	}
+	ay, am, _ := a.Date()
+	by, bm, _ := b.Date()
+	months := (by-ay)*12 + int(bm-am)
+	if a.Compare(b) <= 0 {
+		for months > 0 && IsAfter(a.AddDate(0, months, 0), b) {
+			months--
+		}
+	} else {
+		for months < 0 && IsBefore(a.AddDate(0, months, 0), b) {
+			months++
+		}
+	}
+	days := int(b.Days - a.AddDate(0, months, 0).Days)
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// String returns p in ISO 8601 format, e.g. P1Y2M10D, P-1M or P0D.
+func (p Period) String() string {
+	b, _ := p.AppendText(make([]byte, 0, 16))
+	return string(b)
+}
+
+// AppendText implements encoding.TextAppender using the same format as String.
+func (p Period) AppendText(b []byte) ([]byte, error) {
+	b = append(b, 'P')
+	if p.IsZero() {
+		return append(b, "0D"...), nil
+	}
+	if p.Years != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Years), 10), 'Y')
+	}
+	if p.Months != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Months), 10), 'M')
+	}
+	if p.Days != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Days), 10), 'D')
+	}
+	return b, nil
+}
+
+// ParsePeriod parses an ISO 8601 date duration such as P1Y2M10D, P2W or
+// -P1M. Components may carry their own sign, e.g. P1Y-2M, and weeks are
+// converted to days. Time components are not supported.
+func ParsePeriod(s string) (Period, error) {
+	src := strings.ToUpper(s)
+	sign := 1
+	switch {
+	case strings.HasPrefix(src, "-"):
+		sign, src = -1, src[1:]
+	case strings.HasPrefix(src, "+"):
+		src = src[1:]
+	}
+	if !strings.HasPrefix(src, "P") || len(src) < 3 {
+		return Period{}, fmt.Errorf("invalid period %q", s)
+	}
+	src = src[1:]
+
+	var p Period
+	const order = "YMWD"
+	last := -1
+	for src != "" {
+		end := strings.IndexAny(src, order+"T")
+		if end < 0 {
+			return Period{}, fmt.Errorf("invalid period %q: missing unit", s)
+		}
+		if src[end] == 'T' {
+			return Period{}, fmt.Errorf("invalid period %q: time components are not supported", s)
+		}
+		unit := strings.IndexByte(order, src[end])
+		if unit <= last {
+			return Period{}, fmt.Errorf("invalid period %q: unit %c out of order", s, src[end])
+		}
+		last = unit
+		n, err := strconv.Atoi(src[:end])
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		switch src[end] {
+		case 'Y':
+			p.Years = n
+		case 'M':
+			p.Months = n
+		case 'W':
+			p.Days += n * 7
+		case 'D':
+			p.Days += n
+		}
+		src = src[end+1:]
+	}
+	if sign < 0 {
+		p = p.Negated()
+	}
+	return p, nil
+}
+
+// parsePostgresInterval parses the default postgres output style of interval,
+// e.g. "1 year 2 mons 10 days" or "-3 days". A time of day part is only
+// accepted if it is zero.
+func parsePostgresInterval(s string) (Period, error) {
+	var p Period
+	fields := strings.Fields(s)
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			if strings.Trim(fields[i], "+-0:.") != "" {
+				return Period{}, fmt.Errorf("invalid period %q: time components are not supported", s)
+			}
+			continue
+		}
+		if i+1 >= len(fields) {
+			return Period{}, fmt.Errorf("invalid period %q: missing unit", s)
+		}
+		n, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		i++
+		switch fields[i] {
+		case "year", "years":
+			p.Years += n
+		case "mon", "mons", "month", "months":
+			p.Months += n
+		case "day", "days":
+			p.Days += n
+		default:
+			return Period{}, fmt.Errorf("invalid period %q: unknown unit %q", s, fields[i])
+		}
+	}
+	if len(fields) == 0 {
+		return Period{}, fmt.Errorf("invalid period %q", s)
+	}
+	return p, nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the ISO 8601 format,
+// which is also used for JSON.
+func (p Period) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, see ParsePeriod.
+func (p *Period) UnmarshalText(text []byte) error {
+	parsed, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// SQL scanning. Accepts intervals in the ISO 8601 and postgres output styles.
+func (p *Period) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		parse := parsePostgresInterval
+		if strings.HasPrefix(strings.TrimLeft(v, "+-"), "P") {
+			parse = ParsePeriod
+		}
+		parsed, err := parse(v)
+		if err != nil {
+			return err
+		}
+		*p = parsed
+		return nil
+	case []byte:
+		return p.Scan(string(v))
+	case pgtype.Interval:
+		return p.ScanInterval(v)
+	case nil:
+		return errors.New("cannot scan NULL into Period")
+	default:
+		return fmt.Errorf("unsupported Scan, storing %T into Period", value)
+	}
+}
+
+// SQL value. PostgreSQL accepts the ISO 8601 format as interval input.
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// pgtype conversion
+func (p Period) PgInterval() pgtype.Interval {
+	return pgtype.Interval{Months: int32(p.TotalMonths()), Days: int32(p.Days), Valid: true}
+}
+
+// ScanInterval implements pgtype.IntervalScanner. Intervals with a time of day
+// part cannot be represented as a Period and return an error.
+func (p *Period) ScanInterval(v pgtype.Interval) error {
+	if !v.Valid {
+		return errors.New("cannot scan NULL into Period")
+	}
+	if v.Microseconds != 0 {
+		return fmt.Errorf("cannot scan interval with %d microseconds into Period", v.Microseconds)
+	}
+	*p = Period{Years: int(v.Months / 12), Months: int(v.Months % 12), Days: int(v.Days)}
+	return nil
+}
+
+// IntervalValue implements pgtype.IntervalValuer.
+func (p Period) IntervalValue() (pgtype.Interval, error) {
+	return p.PgInterval(), nil
+}
@@ -0,0 +1,321 @@
+package localdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Period
+		wantErr bool
+	}{
+		{input: "P1Y2M10D", want: NewPeriod(1, 2, 10)},
+		{input: "P1Y", want: NewPeriod(1, 0, 0)},
+		{input: "P2M", want: NewPeriod(0, 2, 0)},
+		{input: "P10D", want: NewPeriod(0, 0, 10)},
+		{input: "P2W", want: NewPeriod(0, 0, 14)},
+		{input: "P1W3D", want: NewPeriod(0, 0, 10)},
+		{input: "P0D", want: Period{}},
+		{input: "-P1Y2M", want: NewPeriod(-1, -2, 0)},
+		{input: "+P1D", want: NewPeriod(0, 0, 1)},
+		{input: "P1Y-2M", want: NewPeriod(1, -2, 0)},
+		{input: "-P-1D", want: NewPeriod(0, 0, 1)},
+		{input: "p1y2m3d", want: NewPeriod(1, 2, 3)},
+		{input: "", wantErr: true},
+		{input: "P", wantErr: true},
+		{input: "1Y", wantErr: true},
+		{input: "P1", wantErr: true},
+		{input: "PY", wantErr: true},
+		{input: "P1D1Y", wantErr: true},
+		{input: "P1Y1Y", wantErr: true},
+		{input: "PT1H", wantErr: true},
+		{input: "P1DT0S", wantErr: true},
+		{input: "P1.5Y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePeriod(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePeriod(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParsePeriod(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodString(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   string
+	}{
+		{period: NewPeriod(1, 2, 10), want: "P1Y2M10D"},
+		{period: NewPeriod(0, 0, 0), want: "P0D"},
+		{period: NewPeriod(0, 14, 0), want: "P14M"},
+		{period: NewPeriod(-1, 0, -3), want: "P-1Y-3D"},
+		{period: NewPeriod(0, 0, 7), want: "P7D"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.period.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+			back, err := ParsePeriod(tt.want)
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) error = %v", tt.want, err)
+			}
+			if back != tt.period {
+				t.Errorf("ParsePeriod(%q) = %v, want %v", tt.want, back, tt.period)
+			}
+		})
+	}
+}
+
+func TestPeriodArithmetic(t *testing.T) {
+	p := NewPeriod(1, 14, 40)
+	if got, want := p.Normalized(), NewPeriod(2, 2, 40); got != want {
+		t.Errorf("Normalized() = %v, want %v", got, want)
+	}
+	if got, want := NewPeriod(1, -2, 0).Normalized(), NewPeriod(0, 10, 0); got != want {
+		t.Errorf("Normalized() = %v, want %v", got, want)
+	}
+	if got, want := NewPeriod(-1, -14, 0).Normalized(), NewPeriod(-2, -2, 0); got != want {
+		t.Errorf("Normalized() = %v, want %v", got, want)
+	}
+	if got, want := p.Negated(), NewPeriod(-1, -14, -40); got != want {
+		t.Errorf("Negated() = %v, want %v", got, want)
+	}
+	if got, want := p.Plus(NewPeriod(1, 1, 1)), NewPeriod(2, 15, 41); got != want {
+		t.Errorf("Plus() = %v, want %v", got, want)
+	}
+	if got, want := p.Minus(p), (Period{}); got != want {
+		t.Errorf("Minus() = %v, want %v", got, want)
+	}
+	if !(Period{}).IsZero() || p.IsZero() {
+		t.Errorf("IsZero() is wrong")
+	}
+}
+
+func TestLocalDatePlusPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      LocalDate
+		period    Period
+		wantPlus  LocalDate
+		wantMinus LocalDate
+	}{
+		{
+			name:      "years, months and days",
+			date:      NewLocalDate(2023, time.May, 15),
+			period:    NewPeriod(1, 2, 10),
+			wantPlus:  NewLocalDate(2024, time.July, 25),
+			wantMinus: NewLocalDate(2022, time.March, 5),
+		},
+		{
+			name:      "month end overflows like AddDate",
+			date:      NewLocalDate(2023, time.January, 31),
+			period:    NewPeriod(0, 1, 0),
+			wantPlus:  NewLocalDate(2023, time.March, 3),
+			wantMinus: NewLocalDate(2022, time.December, 31),
+		},
+		{
+			name:      "infinity",
+			date:      InfinityDate(),
+			period:    NewPeriod(1, 0, 0),
+			wantPlus:  InfinityDate(),
+			wantMinus: InfinityDate(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Plus(tt.period); !IsEqual(got, tt.wantPlus) {
+				t.Errorf("Plus(%v) = %v, want %v", tt.period, got, tt.wantPlus)
+			}
+			if got := tt.date.Minus(tt.period); !IsEqual(got, tt.wantMinus) {
+				t.Errorf("Minus(%v) = %v, want %v", tt.period, got, tt.wantMinus)
+			}
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a    LocalDate
+		b    LocalDate
+		want Period
+	}{
+		{
+			name: "same date",
+			a:    NewLocalDate(2023, time.May, 15),
+			b:    NewLocalDate(2023, time.May, 15),
+			want: Period{},
+		},
+		{
+			name: "years, months and days",
+			a:    NewLocalDate(2023, time.May, 15),
+			b:    NewLocalDate(2024, time.July, 25),
+			want: NewPeriod(1, 2, 10),
+		},
+		{
+			name: "backwards",
+			a:    NewLocalDate(2024, time.July, 25),
+			b:    NewLocalDate(2023, time.May, 15),
+			want: NewPeriod(-1, -2, -10),
+		},
+		{
+			name: "day of month earlier",
+			a:    NewLocalDate(2023, time.May, 20),
+			b:    NewLocalDate(2023, time.July, 10),
+			want: NewPeriod(0, 1, 20),
+		},
+		{
+			name: "month end",
+			a:    NewLocalDate(2023, time.January, 31),
+			b:    NewLocalDate(2023, time.March, 1),
+			want: NewPeriod(0, 0, 29),
+		},
+		{
+			name: "leap day to next year",
+			a:    NewLocalDate(2024, time.February, 29),
+			b:    NewLocalDate(2025, time.February, 28),
+			want: NewPeriod(0, 11, 30),
+		},
+		{
+			name: "infinity",
+			a:    NewLocalDate(2023, time.May, 15),
+			b:    InfinityDate(),
+			want: Period{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Between(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("Between(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if tt.a.IsFinite() && tt.b.IsFinite() && !IsEqual(tt.a.Plus(got), tt.b) {
+				t.Errorf("%v.Plus(%v) = %v, want %v", tt.a, got, tt.a.Plus(got), tt.b)
+			}
+		})
+	}
+}
+
+func TestBetweenRoundTrip(t *testing.T) {
+	from := NewLocalDate(2023, time.January, 1)
+	to := NewLocalDate(2024, time.December, 31)
+	for a := from; !IsAfter(a, to); a = AddDays(a, 7) {
+		for b := from; !IsAfter(b, to); b = AddDays(b, 3) {
+			p := Between(a, b)
+			if got := a.Plus(p); got != b {
+				t.Fatalf("%v.Plus(Between(%v, %v) = %v) = %v", a, a, b, p, got)
+			}
+			if (p.Years < 0 || p.Months < 0 || p.Days < 0) && (p.Years > 0 || p.Months > 0 || p.Days > 0) {
+				t.Fatalf("Between(%v, %v) = %v has mixed signs", a, b, p)
+			}
+		}
+	}
+}
+
+func TestPeriodJSON(t *testing.T) {
+	type subscription struct {
+		Length Period `json:"length"`
+	}
+	data, err := json.Marshal(subscription{Length: NewPeriod(1, 2, 10)})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"length":"P1Y2M10D"}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	var got subscription
+	if err := json.Unmarshal([]byte(`{"length":"P2W"}`), &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if want := NewPeriod(0, 0, 14); got.Length != want {
+		t.Errorf("Unmarshal() = %v, want %v", got.Length, want)
+	}
+	if err := json.Unmarshal([]byte(`{"length":"PT1H"}`), &got); err == nil {
+		t.Errorf("Expected error for time components")
+	}
+}
+
+func TestPeriodSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    Period
+		wantErr bool
+	}{
+		{name: "iso 8601", value: "P1Y2M10D", want: NewPeriod(1, 2, 10)},
+		{name: "postgres style", value: "1 year 2 mons 10 days", want: NewPeriod(1, 2, 10)},
+		{name: "postgres style plural", value: "2 years 1 mon 1 day", want: NewPeriod(2, 1, 1)},
+		{name: "postgres style negative", value: "-1 years -2 mons +3 days", want: NewPeriod(-1, -2, 3)},
+		{name: "postgres style zero time", value: "3 days 00:00:00", want: NewPeriod(0, 0, 3)},
+		{name: "postgres style zero", value: "00:00:00", want: Period{}},
+		{name: "postgres style bytes", value: []byte("5 days"), want: NewPeriod(0, 0, 5)},
+		{name: "postgres style with time", value: "3 days 01:00:00", wantErr: true},
+		{name: "postgres style unknown unit", value: "3 weeks", wantErr: true},
+		{name: "pgtype interval", value: pgtype.Interval{Months: 14, Days: 10, Valid: true}, want: NewPeriod(1, 2, 10)},
+		{name: "pgtype interval with time", value: pgtype.Interval{Microseconds: 1, Valid: true}, wantErr: true},
+		{name: "pgtype null", value: pgtype.Interval{}, wantErr: true},
+		{name: "nil", value: nil, wantErr: true},
+		{name: "unsupported", value: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Period
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	value, err := NewPeriod(1, 2, 10).Value()
+	if err != nil || value != "P1Y2M10D" {
+		t.Errorf("Value() = %v, %v, want P1Y2M10D", value, err)
+	}
+	if got, want := NewPeriod(1, 2, 10).PgInterval(), (pgtype.Interval{Months: 14, Days: 10, Valid: true}); got != want {
+		t.Errorf("PgInterval() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPeriodPgxCodec(t *testing.T) {
+	m := pgtype.NewMap()
+	RegisterTypes(m)
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		want := NewPeriod(1, 2, -10)
+		buf, err := m.Encode(pgtype.IntervalOID, format, want, nil)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		var got Period
+		if err := m.Scan(pgtype.IntervalOID, format, buf, &got); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("Scan() = %v, want %v", got, want)
+		}
+	}
+
+	typ, ok := m.TypeForValue(Period{})
+	if !ok || typ.OID != pgtype.IntervalOID {
+		t.Errorf("TypeForValue(Period{}) = %v, %v, want interval", typ, ok)
+	}
+}
@@ -0,0 +1,44 @@
+package localdate
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ScanDate implements pgtype.DateScanner so that pgx can scan date values
+// directly into a LocalDate, in both the text and binary protocols.
+func (d *LocalDate) ScanDate(v pgtype.Date) error {
+	*d = FromPgDate(v)
+	return nil
+}
+
+// DateValue implements pgtype.DateValuer so that pgx can encode a LocalDate
+// directly as a date value.
+func (d LocalDate) DateValue() (pgtype.Date, error) {
+	return d.PgDate(), nil
+}
+
+// RegisterTypes registers LocalDate as the default Go type for the PostgreSQL
+// date and date[] types, and Period for interval, on m. It is only needed when
+// pgx has to infer the PostgreSQL type from a Go value, e.g. with
+// QueryExecModeExec or QueryExecModeSimpleProtocol; with prepared statements
+// and COPY the OIDs are already known and LocalDate works without
+// registration.
+//
+// Register it on a single connection with
+//
+//	localdate.RegisterTypes(conn.TypeMap())
+//
+// or on every connection in a pgxpool with
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		localdate.RegisterTypes(conn.TypeMap())
+//		return nil
+//	}
+func RegisterTypes(m *pgtype.Map) {
+	m.RegisterDefaultPgType(LocalDate{}, "date")
+	m.RegisterDefaultPgType(&LocalDate{}, "date")
+	m.RegisterDefaultPgType([]LocalDate{}, "_date")
+	m.RegisterDefaultPgType([]*LocalDate{}, "_date")
+	m.RegisterDefaultPgType(Period{}, "interval")
+	m.RegisterDefaultPgType(&Period{}, "interval")
+}
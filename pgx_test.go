@@ -0,0 +1,104 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestPgxCodec(t *testing.T) {
+	m := pgtype.NewMap()
+	RegisterTypes(m)
+
+	tests := []struct {
+		name string
+		date LocalDate
+	}{
+		{name: "regular date", date: NewLocalDate(2023, time.May, 15)},
+		{name: "before epoch", date: NewLocalDate(1900, time.January, 1)},
+		{name: "leap day", date: NewLocalDate(2024, time.February, 29)},
+		{name: "infinity", date: InfinityDate()},
+		{name: "negative infinity", date: NegInfinityDate()},
+		{name: "invalid", date: LocalDate{}},
+	}
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				buf, err := m.Encode(pgtype.DateOID, format, tt.date, nil)
+				if err != nil {
+					t.Fatalf("Encode(%v) error = %v", tt.date, err)
+				}
+				if !tt.date.Valid && buf != nil {
+					t.Fatalf("Encode(%v) = %v, want NULL", tt.date, buf)
+				}
+
+				got := NewLocalDate(2000, time.January, 1)
+				if err := m.Scan(pgtype.DateOID, format, buf, &got); err != nil {
+					t.Fatalf("Scan() error = %v", err)
+				}
+				if got != tt.date {
+					t.Errorf("Scan() = %v, want %v", got, tt.date)
+				}
+			})
+		}
+	}
+}
+
+func TestPgxCodecArray(t *testing.T) {
+	m := pgtype.NewMap()
+	RegisterTypes(m)
+
+	dates := []LocalDate{
+		NewLocalDate(2023, time.May, 15),
+		InfinityDate(),
+		{},
+		NegInfinityDate(),
+	}
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.DateArrayOID, format, dates, nil)
+		if err != nil {
+			t.Fatalf("Encode(%v) error = %v", dates, err)
+		}
+		var got []LocalDate
+		if err := m.Scan(pgtype.DateArrayOID, format, buf, &got); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		if !slices.Equal(got, dates) {
+			t.Errorf("Scan() = %v, want %v", got, dates)
+		}
+	}
+}
+
+func TestRegisterTypes(t *testing.T) {
+	m := pgtype.NewMap()
+	RegisterTypes(m)
+
+	tests := []struct {
+		name  string
+		value any
+		want  uint32
+	}{
+		{name: "value", value: LocalDate{}, want: pgtype.DateOID},
+		{name: "pointer", value: &LocalDate{}, want: pgtype.DateOID},
+		{name: "slice", value: []LocalDate{}, want: pgtype.DateArrayOID},
+		{name: "slice of pointers", value: []*LocalDate{}, want: pgtype.DateArrayOID},
+		{name: "period", value: Period{}, want: pgtype.IntervalOID},
+		{name: "period pointer", value: &Period{}, want: pgtype.IntervalOID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ, ok := m.TypeForValue(tt.value)
+			if !ok {
+				t.Fatalf("TypeForValue(%T) not found", tt.value)
+			}
+			if typ.OID != tt.want {
+				t.Errorf("TypeForValue(%T) = %v, want %v", tt.value, typ.OID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package localdate
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// Quarter is a calendar quarter such as 2024-Q2, numbered 1 to 4. The zero
+// value is NULL, marshaling to JSON null and SQL NULL. Use NewQuarter to get a
+// normalized value.
+type Quarter struct {
+	Year    int
+	Quarter int
+}
+
+// NewQuarter returns the given quarter, normalizing quarters outside [1, 4],
+// so quarter 5 of 2024 is 2025-Q1.
+func NewQuarter(year, quarter int) Quarter {
+	return quarterFromIndex(int64(year)*4 + int64(quarter-1))
+}
+
+func quarterFromIndex(n int64) Quarter {
+	return Quarter{Year: int(floorDiv(n, 4)), Quarter: int(floorMod(n, 4) + 1)}
+}
+
+func (q Quarter) index() int64 {
+	return int64(q.Year)*4 + int64(q.Quarter-1)
+}
+
+// Quarter returns the quarter of d, or the zero Quarter when d is not finite.
+func (d LocalDate) Quarter() Quarter {
+	year, month, _ := d.Date()
+	if month == 0 {
+		return Quarter{}
+	}
+	return Quarter{Year: year, Quarter: int(month-1)/3 + 1}
+}
+
+// IsZero reports whether q is the zero value, i.e. NULL.
+func (q Quarter) IsZero() bool {
+	return q == Quarter{}
+}
+
+// FirstMonth returns the first month of q, or the zero YearMonth if q is zero.
+func (q Quarter) FirstMonth() YearMonth {
+	if q.IsZero() {
+		return YearMonth{}
+	}
+	return YearMonth{Year: q.Year, Month: time.Month((q.Quarter-1)*3 + 1)}
+}
+
+// FirstDay returns the first day of q, or an invalid date if q is zero.
+func (q Quarter) FirstDay() LocalDate {
+	return q.FirstMonth().FirstDay()
+}
+
+// LastDay returns the last day of q, or an invalid date if q is zero.
+func (q Quarter) LastDay() LocalDate {
+	return q.FirstMonth().AddMonths(2).LastDay()
+}
+
+// Days returns the number of days in q, or 0 if q is zero.
+func (q Quarter) Days() int {
+	if q.IsZero() {
+		return 0
+	}
+	return int(q.LastDay().Days-q.FirstDay().Days) + 1
+}
+
+// Contains reports whether d is a finite date in q.
+func (q Quarter) Contains(d LocalDate) bool {
+	return !q.IsZero() && d.Quarter() == q
+}
+
+// Dates returns an iterator over the dates of q in ascending order.
+func (q Quarter) Dates() iter.Seq[LocalDate] {
+	return unitDates(q)
+}
+
+// AddQuarters returns q with quarters added. The zero Quarter is returned
+// unchanged.
+func (q Quarter) AddQuarters(quarters int) Quarter {
+	if q.IsZero() {
+		return q
+	}
+	return NewQuarter(q.Year, q.Quarter+quarters)
+}
+
+// AddYears returns q with years added. The zero Quarter is returned unchanged.
+func (q Quarter) AddYears(years int) Quarter {
+	return q.AddQuarters(years * 4)
+}
+
+// Next returns the quarter after q.
+func (q Quarter) Next() Quarter {
+	return q.AddQuarters(1)
+}
+
+// Prev returns the quarter before q.
+func (q Quarter) Prev() Quarter {
+	return q.AddQuarters(-1)
+}
+
+// Compare returns -1, 0 or 1 depending on whether q is before, equal to or
+// after other. The zero Quarter sorts before all others.
+func (q Quarter) Compare(other Quarter) int {
+	return compareUnits(q, other)
+}
+
+// Sub returns the number of quarters from other to q.
+func (q Quarter) Sub(other Quarter) int {
+	return int(q.index() - other.index())
+}
+
+// EachQuarter returns an iterator over the quarters from from to to, both
+// included, iterating backward if to is before from. It returns
+// ErrInvalidDate if either quarter is zero.
+func EachQuarter(from, to Quarter) (iter.Seq[Quarter], error) {
+	return eachUnit(from, to, quarterFromIndex)
+}
+
+// String returns q in the format 2006-Q1, or an empty string if q is zero.
+func (q Quarter) String() string {
+	b, _ := q.AppendText(make([]byte, 0, 7))
+	return string(b)
+}
+
+// AppendText implements encoding.TextAppender using the same format as String.
+func (q Quarter) AppendText(b []byte) ([]byte, error) {
+	if q.IsZero() {
+		return b, nil
+	}
+	return appendPartOfYear(b, q.Year, 'Q', q.Quarter), nil
+}
+
+// ParseQuarter parses a quarter in the format 2006-Q1.
+func ParseQuarter(s string) (Quarter, error) {
+	year, n, ok := parsePartOfYear(s, 'Q', 4)
+	if !ok {
+		return Quarter{}, fmt.Errorf("invalid quarter %q", s)
+	}
+	return Quarter{Year: year, Quarter: n}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the same format as
+// String, so the zero Quarter marshals to empty text.
+func (q Quarter) MarshalText() ([]byte, error) {
+	return q.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is the zero
+// Quarter.
+func (q *Quarter) UnmarshalText(text []byte) error {
+	return unmarshalUnitText(q, text, ParseQuarter)
+}
+
+// MarshalJSON encodes the zero Quarter as null
+func (q Quarter) MarshalJSON() ([]byte, error) {
+	return marshalUnitJSON(q)
+}
+
+// UnmarshalJSON decodes null as the zero Quarter
+func (q *Quarter) UnmarshalJSON(data []byte) error {
+	return unmarshalUnitJSON(q, data, ParseQuarter)
+}
+
+// SQL scanning. Accepts a quarter in the format 2006-Q1 or a date that is the
+// first day of a quarter. NULL scans as the zero Quarter.
+func (q *Quarter) Scan(value interface{}) error {
+	return scanUnit(q, value, "Quarter", ParseQuarter, LocalDate.Quarter)
+}
+
+// SQL value. The quarter is stored as its first day, the zero Quarter as NULL.
+func (q Quarter) Value() (driver.Value, error) {
+	return q.FirstDay().Value()
+}
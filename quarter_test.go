@@ -0,0 +1,204 @@
+package localdate
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestQuarter(t *testing.T) {
+	tests := []struct {
+		date      LocalDate
+		want      Quarter
+		wantFirst LocalDate
+		wantLast  LocalDate
+		wantDays  int
+	}{
+		{NewLocalDate(2024, time.February, 29), Quarter{2024, 1}, NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.March, 31), 91},
+		{NewLocalDate(2023, time.April, 1), Quarter{2023, 2}, NewLocalDate(2023, time.April, 1), NewLocalDate(2023, time.June, 30), 91},
+		{NewLocalDate(2023, time.September, 30), Quarter{2023, 3}, NewLocalDate(2023, time.July, 1), NewLocalDate(2023, time.September, 30), 92},
+		{NewLocalDate(2023, time.December, 31), Quarter{2023, 4}, NewLocalDate(2023, time.October, 1), NewLocalDate(2023, time.December, 31), 92},
+		{InfinityDate(), Quarter{}, LocalDate{}, LocalDate{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			q := tt.date.Quarter()
+			if q != tt.want {
+				t.Fatalf("Quarter() = %v, want %v", q, tt.want)
+			}
+			if got := q.FirstDay(); got != tt.wantFirst {
+				t.Errorf("FirstDay() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := q.LastDay(); got != tt.wantLast {
+				t.Errorf("LastDay() = %v, want %v", got, tt.wantLast)
+			}
+			if got := q.Days(); got != tt.wantDays {
+				t.Errorf("Days() = %v, want %v", got, tt.wantDays)
+			}
+			if got := len(slices.Collect(q.Dates())); got != tt.wantDays {
+				t.Errorf("len(Dates()) = %v, want %v", got, tt.wantDays)
+			}
+			if got := q.Contains(tt.date); got != !q.IsZero() {
+				t.Errorf("Contains(%v) = %v", tt.date, got)
+			}
+		})
+	}
+
+	if (Quarter{2024, 1}).Contains(NewLocalDate(2024, time.April, 1)) {
+		t.Errorf("Expected 2024-Q1 not to contain 2024-04-01")
+	}
+}
+
+func TestQuarterArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Quarter
+		want Quarter
+	}{
+		{name: "normalize", got: NewQuarter(2024, 5), want: Quarter{2025, 1}},
+		{name: "normalize zero", got: NewQuarter(2024, 0), want: Quarter{2023, 4}},
+		{name: "add", got: Quarter{2024, 3}.AddQuarters(6), want: Quarter{2026, 1}},
+		{name: "subtract", got: Quarter{2024, 1}.AddQuarters(-5), want: Quarter{2022, 4}},
+		{name: "add years", got: Quarter{2024, 2}.AddYears(1), want: Quarter{2025, 2}},
+		{name: "next", got: Quarter{2024, 4}.Next(), want: Quarter{2025, 1}},
+		{name: "prev", got: Quarter{2024, 1}.Prev(), want: Quarter{2023, 4}},
+		{name: "zero unchanged", got: Quarter{}.Next(), want: Quarter{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := (Quarter{2025, 2}).Sub(Quarter{2024, 3}); got != 3 {
+		t.Errorf("Sub() = %v, want 3", got)
+	}
+	if got := (Quarter{2024, 4}).Compare(Quarter{2025, 1}); got != -1 {
+		t.Errorf("Compare() = %v, want -1", got)
+	}
+	if got := (Quarter{2024, 4}).FirstMonth(); got != (YearMonth{2024, time.October}) {
+		t.Errorf("FirstMonth() = %v, want 2024-10", got)
+	}
+
+	seq, err := EachQuarter(Quarter{2024, 3}, Quarter{2025, 1})
+	if err != nil {
+		t.Fatalf("EachQuarter() error = %v", err)
+	}
+	want := []Quarter{{2024, 3}, {2024, 4}, {2025, 1}}
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachQuarter() = %v, want %v", got, want)
+	}
+	seq, _ = EachQuarter(Quarter{2025, 1}, Quarter{2024, 3})
+	slices.Reverse(want)
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachQuarter() = %v, want %v", got, want)
+	}
+	if _, err := EachQuarter(Quarter{}, Quarter{2024, 1}); err == nil {
+		t.Errorf("Expected error for zero Quarter")
+	}
+}
+
+func TestParseQuarter(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Quarter
+		wantErr bool
+	}{
+		{input: "2024-Q2", want: Quarter{2024, 2}},
+		{input: "-0001-Q4", want: Quarter{-1, 4}},
+		{input: "2024-Q0", wantErr: true},
+		{input: "2024-Q5", wantErr: true},
+		{input: "2024-q2", wantErr: true},
+		{input: "2024Q2", wantErr: true},
+		{input: "2024-H1", wantErr: true},
+		{input: "24-Q1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseQuarter(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseQuarter(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuarter(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.input {
+				t.Errorf("String() = %v, want %v", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestQuarterJSON(t *testing.T) {
+	type row struct {
+		Quarter Quarter `json:"quarter"`
+		Prev    Quarter `json:"prev"`
+	}
+
+	in := row{Quarter: Quarter{2024, 2}}
+	got, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"quarter":"2024-Q2","prev":null}`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+
+	out := row{Prev: Quarter{2000, 1}}
+	if err := json.Unmarshal(got, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out != in {
+		t.Errorf("Unmarshal() = %+v, want %+v", out, in)
+	}
+}
+
+func TestQuarterSQL(t *testing.T) {
+	want := Quarter{2024, 2}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    Quarter
+		wantErr bool
+	}{
+		{name: "quarter string", input: "2024-Q2", want: want},
+		{name: "first day string", input: "2024-04-01", want: want},
+		{name: "bytes", input: []byte("2024-Q2"), want: want},
+		{name: "time", input: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), want: want},
+		{name: "pgtype date", input: NewLocalDate(2024, time.April, 1).PgDate(), want: want},
+		{name: "nil", input: nil, want: Quarter{}},
+		{name: "not first day", input: "2024-05-01", wantErr: true},
+		{name: "unsupported", input: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Quarter{2000, 1}
+			err := got.Scan(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, want 2024-04-01", value)
+	}
+	if value, err := (Quarter{}).Value(); value != nil || err != nil {
+		t.Errorf("Value(zero) = %v, %v, want nil", value, err)
+	}
+}
@@ -0,0 +1,280 @@
+package localdate
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// RetailPattern is the number of weeks in each of the three periods of a
+// retail quarter.
+type RetailPattern int
+
+const (
+	Pattern445 RetailPattern = iota
+	Pattern454
+	Pattern544
+)
+
+// YearEndRule decides on which day a retail year ends.
+type YearEndRule int
+
+const (
+	// YearEndLastWeekday ends the year on the last EndWeekday of EndMonth.
+	YearEndLastWeekday YearEndRule = iota
+	// YearEndNearest ends the year on the EndWeekday nearest to the last day
+	// of EndMonth, which may fall in the following month.
+	YearEndNearest
+)
+
+// RetailCalendar is a 52/53-week retail calendar. Every year consists of whole
+// weeks ending on EndWeekday, grouped into four quarters of three periods
+// following Pattern. Years with 53 weeks add the extra week to Week53Period.
+type RetailCalendar struct {
+	Pattern RetailPattern
+	// EndMonth is the month the year ends in or near. It must be set.
+	EndMonth time.Month
+	// EndWeekday is the last day of every retail week.
+	EndWeekday time.Weekday
+	YearEnd    YearEndRule
+	// Week53Period is the period in [1, 12] that gets the 53rd week. Zero
+	// means the last period.
+	Week53Period int
+	// YearLabel decides how retail years are numbered. LabelEndYear names a
+	// year after the year of the EndMonth it ends in or near, LabelStartYear
+	// after the calendar year its first full month is in.
+	YearLabel YearLabel
+}
+
+// NRFCalendar returns the 4-5-4 calendar of the National Retail Federation,
+// whose years end on the Saturday nearest the end of January and are named
+// after the calendar year they start in.
+func NRFCalendar() RetailCalendar {
+	return RetailCalendar{
+		Pattern:    Pattern454,
+		EndMonth:   time.January,
+		EndWeekday: time.Saturday,
+		YearEnd:    YearEndNearest,
+		YearLabel:  LabelStartYear,
+	}
+}
+
+// Validate returns an error if c has a field out of range. The other methods
+// do not validate c, but fall back to Pattern445 for an unknown Pattern and to
+// the last period for a Week53Period out of range.
+func (c RetailCalendar) Validate() error {
+	var errs []error
+	if c.Pattern < Pattern445 || c.Pattern > Pattern544 {
+		errs = append(errs, fmt.Errorf("localdate: unknown retail pattern %d", c.Pattern))
+	}
+	if c.EndMonth < time.January || c.EndMonth > time.December {
+		errs = append(errs, fmt.Errorf("localdate: retail end month %d out of range", c.EndMonth))
+	}
+	if c.EndWeekday < time.Sunday || c.EndWeekday > time.Saturday {
+		errs = append(errs, fmt.Errorf("localdate: retail end weekday %d out of range", c.EndWeekday))
+	}
+	if c.YearEnd != YearEndLastWeekday && c.YearEnd != YearEndNearest {
+		errs = append(errs, fmt.Errorf("localdate: unknown year end rule %d", c.YearEnd))
+	}
+	if c.Week53Period < 0 || c.Week53Period > 12 {
+		errs = append(errs, fmt.Errorf("localdate: week 53 period %d out of range [1, 12]", c.Week53Period))
+	}
+	if c.YearLabel != LabelEndYear && c.YearLabel != LabelStartYear {
+		errs = append(errs, fmt.Errorf("localdate: unknown year label %d", c.YearLabel))
+	}
+	return errors.Join(errs...)
+}
+
+// labelOffset returns the calendar year a retail year ends in, minus its
+// label.
+func (c RetailCalendar) labelOffset() int {
+	if c.YearLabel == LabelStartYear && c.EndMonth != time.December {
+		return 1
+	}
+	return 0
+}
+
+// yearEnd returns the last day of the retail year ending in or near EndMonth
+// of the given calendar year.
+func (c RetailCalendar) yearEnd(year int) int64 {
+	last := daysFromCivil(int64(year), c.EndMonth, daysInMonth(int64(year), c.EndMonth))
+	back := floorMod(int64(weekdayOf(last)-c.EndWeekday), 7)
+	if c.YearEnd == YearEndNearest && back > 3 {
+		return last - back + 7
+	}
+	return last - back
+}
+
+// bounds returns the first and last day of the retail year with the given
+// label.
+func (c RetailCalendar) bounds(year int) (first, last int64) {
+	end := year + c.labelOffset()
+	return c.yearEnd(end-1) + 1, c.yearEnd(end)
+}
+
+// locate returns the label of the retail year containing the finite date d and
+// the number of days since that year started.
+func (c RetailCalendar) locate(d LocalDate) (year int, day int) {
+	x := int64(d.Days)
+	end := d.Year()
+	for x > c.yearEnd(end) {
+		end++
+	}
+	for x <= c.yearEnd(end-1) {
+		end--
+	}
+	return end - c.labelOffset(), int(x - c.yearEnd(end-1) - 1)
+}
+
+// WeeksInYear returns the number of weeks in the given retail year, 52 or 53.
+func (c RetailCalendar) WeeksInYear(year int) int {
+	first, last := c.bounds(year)
+	return int(last-first+1) / 7
+}
+
+// periodWeeks returns the number of weeks in each period of the given retail
+// year. An unknown Pattern is treated as Pattern445 and a Week53Period out of
+// range as the last period, so that a config failing Validate cannot panic.
+func (c RetailCalendar) periodWeeks(year int) [12]int {
+	quarter, ok := map[RetailPattern][3]int{
+		Pattern445: {4, 4, 5},
+		Pattern454: {4, 5, 4},
+		Pattern544: {5, 4, 4},
+	}[c.Pattern]
+	if !ok {
+		quarter = [3]int{4, 4, 5}
+	}
+	var weeks [12]int
+	for i := range weeks {
+		weeks[i] = quarter[i%3]
+	}
+	if c.WeeksInYear(year) == 53 {
+		p := c.Week53Period
+		if p < 1 || p > 12 {
+			p = 12
+		}
+		weeks[p-1]++
+	}
+	return weeks
+}
+
+// weekPeriod returns the retail period weeks numbered from first to last,
+// counted from 1, in the given retail year.
+func (c RetailCalendar) weekPeriod(year, number, first, last int) FiscalPeriod {
+	start, _ := c.bounds(year)
+	return FiscalPeriod{
+		Year:   year,
+		Number: number,
+		Start:  LocalDate{Days: int32(start + int64(first-1)*7), Valid: true},
+		End:    LocalDate{Days: int32(start + int64(last)*7 - 1), Valid: true},
+	}
+}
+
+// Year returns the retail year containing d, or the zero FiscalPeriod if d is
+// not finite.
+func (c RetailCalendar) Year(d LocalDate) FiscalPeriod {
+	if !d.IsFinite() {
+		return FiscalPeriod{}
+	}
+	year, _ := c.locate(d)
+	return c.RetailYear(year)
+}
+
+// Quarter returns the retail quarter containing d, or the zero FiscalPeriod if
+// d is not finite.
+func (c RetailCalendar) Quarter(d LocalDate) FiscalPeriod {
+	p := c.Period(d)
+	if p.Number == 0 {
+		return FiscalPeriod{}
+	}
+	return c.RetailQuarter(p.Year, (p.Number+2)/3)
+}
+
+// Period returns the retail period, i.e. retail month, containing d, or the
+// zero FiscalPeriod if d is not finite.
+func (c RetailCalendar) Period(d LocalDate) FiscalPeriod {
+	if !d.IsFinite() {
+		return FiscalPeriod{}
+	}
+	year, day := c.locate(d)
+	week := day/7 + 1
+	weeks := c.periodWeeks(year)
+	p := 1
+	for week > weeks[p-1] {
+		week -= weeks[p-1]
+		p++
+	}
+	return c.RetailPeriod(year, p)
+}
+
+// Week returns the retail week containing d, numbered from 1 within the
+// retail year, or the zero FiscalPeriod if d is not finite.
+func (c RetailCalendar) Week(d LocalDate) FiscalPeriod {
+	if !d.IsFinite() {
+		return FiscalPeriod{}
+	}
+	year, day := c.locate(d)
+	return c.RetailWeek(year, day/7+1)
+}
+
+// RetailYear returns the given retail year.
+func (c RetailCalendar) RetailYear(year int) FiscalPeriod {
+	return c.weekPeriod(year, 0, 1, c.WeeksInYear(year))
+}
+
+// RetailQuarter returns quarter q of the given retail year, or the zero
+// FiscalPeriod if q is not in [1, 4].
+func (c RetailCalendar) RetailQuarter(year, q int) FiscalPeriod {
+	if q < 1 || q > 4 {
+		return FiscalPeriod{}
+	}
+	first, last := c.RetailPeriod(year, q*3-2), c.RetailPeriod(year, q*3)
+	return FiscalPeriod{Year: year, Number: q, Start: first.Start, End: last.End}
+}
+
+// RetailPeriod returns period p of the given retail year, or the zero
+// FiscalPeriod if p is not in [1, 12].
+func (c RetailCalendar) RetailPeriod(year, p int) FiscalPeriod {
+	if p < 1 || p > 12 {
+		return FiscalPeriod{}
+	}
+	weeks := c.periodWeeks(year)
+	first := 1
+	for _, n := range weeks[:p-1] {
+		first += n
+	}
+	return c.weekPeriod(year, p, first, first+weeks[p-1]-1)
+}
+
+// RetailWeek returns week w of the given retail year, or the zero
+// FiscalPeriod if the year has no such week.
+func (c RetailCalendar) RetailWeek(year, w int) FiscalPeriod {
+	if w < 1 || w > c.WeeksInYear(year) {
+		return FiscalPeriod{}
+	}
+	return c.weekPeriod(year, w, w, w)
+}
+
+// Periods returns an iterator over the twelve periods of the given retail
+// year.
+func (c RetailCalendar) Periods(year int) iter.Seq[FiscalPeriod] {
+	return func(yield func(FiscalPeriod) bool) {
+		for p := 1; p <= 12; p++ {
+			if !yield(c.RetailPeriod(year, p)) {
+				return
+			}
+		}
+	}
+}
+
+// Weeks returns an iterator over the weeks of the given retail year.
+func (c RetailCalendar) Weeks(year int) iter.Seq[FiscalPeriod] {
+	return func(yield func(FiscalPeriod) bool) {
+		for w := 1; w <= c.WeeksInYear(year); w++ {
+			if !yield(c.RetailWeek(year, w)) {
+				return
+			}
+		}
+	}
+}
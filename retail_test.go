@@ -0,0 +1,256 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRetailCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cal     RetailCalendar
+		wantErr bool
+	}{
+		{name: "nrf", cal: NRFCalendar()},
+		{name: "december", cal: RetailCalendar{EndMonth: time.December, Week53Period: 1}},
+		{name: "missing end month", cal: RetailCalendar{}, wantErr: true},
+		{name: "unknown pattern", cal: RetailCalendar{Pattern: 3, EndMonth: time.January}, wantErr: true},
+		{name: "end weekday out of range", cal: RetailCalendar{EndMonth: time.January, EndWeekday: 7}, wantErr: true},
+		{name: "unknown year end", cal: RetailCalendar{EndMonth: time.January, YearEnd: 2}, wantErr: true},
+		{name: "week 53 period out of range", cal: RetailCalendar{EndMonth: time.January, Week53Period: 13}, wantErr: true},
+		{name: "unknown year label", cal: RetailCalendar{EndMonth: time.January, YearLabel: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cal.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetailCalendarInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cal  RetailCalendar
+	}{
+		{name: "unknown pattern", cal: RetailCalendar{Pattern: 7, EndMonth: time.January, EndWeekday: time.Saturday, YearEnd: YearEndNearest}},
+		{name: "week 53 period too large", cal: RetailCalendar{EndMonth: time.January, EndWeekday: time.Saturday, YearEnd: YearEndNearest, Week53Period: 13}},
+		{name: "negative week 53 period", cal: RetailCalendar{EndMonth: time.January, EndWeekday: time.Saturday, YearEnd: YearEndNearest, Week53Period: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.cal.Validate() == nil {
+				t.Fatalf("Validate() = nil, want error")
+			}
+			// the year ending near January 2024 has 53 weeks
+			first, last := tt.cal.bounds(2024)
+			for x := first; x <= last; x++ {
+				d := LocalDate{Days: int32(x), Valid: true}
+				if p := tt.cal.Period(d); !p.Contains(d) {
+					t.Fatalf("Period(%v) = %+v does not contain it", d, p)
+				}
+				if w := tt.cal.Week(d); !w.Contains(d) {
+					t.Fatalf("Week(%v) = %+v does not contain it", d, w)
+				}
+			}
+			days := 0
+			for p := range tt.cal.Periods(2024) {
+				days += p.Days()
+			}
+			if days != 53*7 {
+				t.Errorf("Periods() cover %d days, want %d", days, 53*7)
+			}
+		})
+	}
+}
+
+func TestNRFCalendar(t *testing.T) {
+	nrf := NRFCalendar()
+
+	years := []struct {
+		year      int
+		wantFirst LocalDate
+		wantLast  LocalDate
+		wantWeeks int
+	}{
+		{2022, NewLocalDate(2022, time.January, 30), NewLocalDate(2023, time.January, 28), 52},
+		{2023, NewLocalDate(2023, time.January, 29), NewLocalDate(2024, time.February, 3), 53},
+		{2024, NewLocalDate(2024, time.February, 4), NewLocalDate(2025, time.February, 1), 52},
+	}
+	for _, tt := range years {
+		got := nrf.RetailYear(tt.year)
+		if got.Start != tt.wantFirst || got.End != tt.wantLast {
+			t.Errorf("RetailYear(%d) = %v to %v, want %v to %v", tt.year, got.Start, got.End, tt.wantFirst, tt.wantLast)
+		}
+		if got := nrf.WeeksInYear(tt.year); got != tt.wantWeeks {
+			t.Errorf("WeeksInYear(%d) = %v, want %v", tt.year, got, tt.wantWeeks)
+		}
+	}
+
+	tests := []struct {
+		date        LocalDate
+		wantYear    int
+		wantQuarter int
+		wantPeriod  FiscalPeriod
+		wantWeek    int
+	}{
+		{
+			date:        NewLocalDate(2024, time.February, 4),
+			wantYear:    2024,
+			wantQuarter: 1,
+			wantPeriod:  FiscalPeriod{2024, 1, NewLocalDate(2024, time.February, 4), NewLocalDate(2024, time.March, 2)},
+			wantWeek:    1,
+		},
+		{
+			date:        NewLocalDate(2024, time.March, 3),
+			wantYear:    2024,
+			wantQuarter: 1,
+			wantPeriod:  FiscalPeriod{2024, 2, NewLocalDate(2024, time.March, 3), NewLocalDate(2024, time.April, 6)},
+			wantWeek:    5,
+		},
+		{
+			date:        NewLocalDate(2024, time.February, 3),
+			wantYear:    2023,
+			wantQuarter: 4,
+			wantPeriod:  FiscalPeriod{2023, 12, NewLocalDate(2023, time.December, 31), NewLocalDate(2024, time.February, 3)},
+			wantWeek:    53,
+		},
+		{
+			date:        NewLocalDate(2023, time.January, 28),
+			wantYear:    2022,
+			wantQuarter: 4,
+			wantPeriod:  FiscalPeriod{2022, 12, NewLocalDate(2023, time.January, 1), NewLocalDate(2023, time.January, 28)},
+			wantWeek:    52,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			if got := nrf.Year(tt.date).Year; got != tt.wantYear {
+				t.Errorf("Year() = %v, want %v", got, tt.wantYear)
+			}
+			if got := nrf.Quarter(tt.date); got.Number != tt.wantQuarter || !got.Contains(tt.date) {
+				t.Errorf("Quarter() = %+v, want quarter %v", got, tt.wantQuarter)
+			}
+			if got := nrf.Period(tt.date); got != tt.wantPeriod {
+				t.Errorf("Period() = %+v, want %+v", got, tt.wantPeriod)
+			}
+			week := nrf.Week(tt.date)
+			if week.Number != tt.wantWeek || !week.Contains(tt.date) || week.Days() != 7 || week.End.Weekday() != time.Saturday {
+				t.Errorf("Week() = %+v, want week %v", week, tt.wantWeek)
+			}
+		})
+	}
+
+	if got := nrf.Week(InfinityDate()); got != (FiscalPeriod{}) {
+		t.Errorf("Week(infinity) = %+v, want zero", got)
+	}
+	if got := nrf.Quarter(LocalDate{}); got != (FiscalPeriod{}) {
+		t.Errorf("Quarter(invalid) = %+v, want zero", got)
+	}
+}
+
+func TestRetailCalendarPatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		cal     RetailCalendar
+		year    int
+		wantLen []int
+	}{
+		{
+			name:    "445 with week 53 in first period",
+			cal:     RetailCalendar{Pattern: Pattern445, EndMonth: time.December, EndWeekday: time.Sunday, Week53Period: 1},
+			year:    2023,
+			wantLen: []int{5, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5},
+		},
+		{
+			name:    "445 without week 53",
+			cal:     RetailCalendar{Pattern: Pattern445, EndMonth: time.December, EndWeekday: time.Sunday, Week53Period: 1},
+			year:    2024,
+			wantLen: []int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5},
+		},
+		{
+			name:    "544 with week 53 in last period",
+			cal:     RetailCalendar{Pattern: Pattern544, EndMonth: time.December, EndWeekday: time.Sunday},
+			year:    2023,
+			wantLen: []int{5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			next := tt.cal.RetailYear(tt.year).Start
+			for p := range tt.cal.Periods(tt.year) {
+				if p.Start != next {
+					t.Errorf("period %d starts %v, want %v", p.Number, p.Start, next)
+				}
+				next = AddDays(p.End, 1)
+				got = append(got, p.Days()/7)
+			}
+			if !slices.Equal(got, tt.wantLen) {
+				t.Errorf("Periods() weeks = %v, want %v", got, tt.wantLen)
+			}
+			if weeks := slices.Collect(tt.cal.Weeks(tt.year)); len(weeks) != tt.cal.WeeksInYear(tt.year) {
+				t.Errorf("len(Weeks()) = %v, want %v", len(weeks), tt.cal.WeeksInYear(tt.year))
+			}
+		})
+	}
+}
+
+func TestRetailCalendarYearEnd(t *testing.T) {
+	tests := []struct {
+		name      string
+		cal       RetailCalendar
+		date      LocalDate
+		wantYear  int
+		wantStart LocalDate
+		wantEnd   LocalDate
+	}{
+		{
+			name:      "last sunday of december",
+			cal:       RetailCalendar{EndMonth: time.December, EndWeekday: time.Sunday},
+			date:      NewLocalDate(2024, time.December, 30),
+			wantYear:  2025,
+			wantStart: NewLocalDate(2024, time.December, 30),
+			wantEnd:   NewLocalDate(2025, time.December, 28),
+		},
+		{
+			name:      "nearest saturday spills into january",
+			cal:       RetailCalendar{EndMonth: time.December, EndWeekday: time.Saturday, YearEnd: YearEndNearest},
+			date:      NewLocalDate(2022, time.January, 1),
+			wantYear:  2021,
+			wantStart: NewLocalDate(2021, time.January, 3),
+			wantEnd:   NewLocalDate(2022, time.January, 1),
+		},
+		{
+			name:      "labeled by end year",
+			cal:       RetailCalendar{EndMonth: time.January, EndWeekday: time.Saturday, YearEnd: YearEndNearest},
+			date:      NewLocalDate(2024, time.June, 1),
+			wantYear:  2025,
+			wantStart: NewLocalDate(2024, time.February, 4),
+			wantEnd:   NewLocalDate(2025, time.February, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cal.Year(tt.date)
+			if got.Year != tt.wantYear || got.Start != tt.wantStart || got.End != tt.wantEnd {
+				t.Errorf("Year() = %+v, want %v from %v to %v", got, tt.wantYear, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+
+	nrf := NRFCalendar()
+	if got := nrf.RetailWeek(2024, 53); got != (FiscalPeriod{}) {
+		t.Errorf("RetailWeek(2024, 53) = %+v, want zero", got)
+	}
+	if got := nrf.RetailPeriod(2024, 13); got != (FiscalPeriod{}) {
+		t.Errorf("RetailPeriod(2024, 13) = %+v, want zero", got)
+	}
+}
@@ -0,0 +1,92 @@
+package localdate
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// EachDay returns an iterator over every date from from to to, both included.
+// It iterates backward if to is before from. It refuses to iterate to or from
+// an infinite or invalid date by returning ErrInfiniteDate or ErrInvalidDate.
+func EachDay(from, to LocalDate) (iter.Seq[LocalDate], error) {
+	return EachNDays(from, to, 1)
+}
+
+// EachWeek returns an iterator over from and every seventh date after it, up to
+// and including to. See EachDay for direction and errors.
+func EachWeek(from, to LocalDate) (iter.Seq[LocalDate], error) {
+	return EachNDays(from, to, 7)
+}
+
+// EachNDays returns an iterator over from and every n:th date after it, up to
+// and including to. n must be positive; the direction is given by from and to.
+// See EachDay for errors.
+func EachNDays(from, to LocalDate, n int) (iter.Seq[LocalDate], error) {
+	if err := checkFinite(from, to); err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		return nil, fmt.Errorf("localdate: step must be positive, got %d", n)
+	}
+	step := int64(n)
+	if IsBefore(to, from) {
+		step = -step
+	}
+	first, last := int64(from.Days), int64(to.Days)
+	return func(yield func(LocalDate) bool) {
+		for d := first; (step > 0 && d <= last) || (step < 0 && d >= last); d += step {
+			if !yield(LocalDate{Days: int32(d), Valid: true}) {
+				return
+			}
+		}
+	}, nil
+}
+
+// EachMonth returns an iterator over from and the same day of every following
+// month, up to and including to, iterating backward if to is before from.
+// Every date is computed from from rather than from the previous date, so with
+// OverflowClamp 2024-01-31 is followed by 2024-02-29 and then 2024-03-31.
+// Month-ends stay month-ends with OverflowEndOfMonth, and with OverflowError
+// months that lack the day are skipped. See EachDay for errors.
+func EachMonth(from, to LocalDate, policy OverflowPolicy) (iter.Seq[LocalDate], error) {
+	return eachMonths(from, to, 1, policy)
+}
+
+// EachYear returns an iterator over from and the same date of every following
+// year, up to and including to, resolving February 29 according to policy. It
+// otherwise behaves like EachMonth.
+func EachYear(from, to LocalDate, policy OverflowPolicy) (iter.Seq[LocalDate], error) {
+	return eachMonths(from, to, 12, policy)
+}
+
+func eachMonths(from, to LocalDate, months int, policy OverflowPolicy) (iter.Seq[LocalDate], error) {
+	if err := checkFinite(from, to); err != nil {
+		return nil, err
+	}
+	if _, err := from.AddMonths(0, policy); err != nil {
+		return nil, err
+	}
+	backward := IsBefore(to, from)
+	if backward {
+		months = -months
+	}
+	return func(yield func(LocalDate) bool) {
+		for i := 0; ; i++ {
+			d, err := from.AddMonths(i*months, policy)
+			if errors.Is(err, ErrNonexistentDate) {
+				continue
+			}
+			if err != nil {
+				// past the first or last representable date
+				return
+			}
+			if (!backward && IsAfter(d, to)) || (backward && IsBefore(d, to)) {
+				return
+			}
+			if !yield(d) {
+				return
+			}
+		}
+	}, nil
+}
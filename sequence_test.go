@@ -0,0 +1,261 @@
+package localdate
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func dates(days ...[3]int) []LocalDate {
+	var out []LocalDate
+	for _, d := range days {
+		out = append(out, NewLocalDate(d[0], time.Month(d[1]), d[2]))
+	}
+	return out
+}
+
+func TestEachDay(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  func() (iter.Seq[LocalDate], error)
+		want []LocalDate
+	}{
+		{
+			name: "forward",
+			seq: func() (iter.Seq[LocalDate], error) {
+				return EachDay(NewLocalDate(2024, time.February, 27), NewLocalDate(2024, time.March, 1))
+			},
+			want: dates([3]int{2024, 2, 27}, [3]int{2024, 2, 28}, [3]int{2024, 2, 29}, [3]int{2024, 3, 1}),
+		},
+		{
+			name: "backward",
+			seq: func() (iter.Seq[LocalDate], error) {
+				return EachDay(NewLocalDate(2024, time.January, 2), NewLocalDate(2023, time.December, 31))
+			},
+			want: dates([3]int{2024, 1, 2}, [3]int{2024, 1, 1}, [3]int{2023, 12, 31}),
+		},
+		{
+			name: "single date",
+			seq: func() (iter.Seq[LocalDate], error) {
+				return EachDay(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.January, 1))
+			},
+			want: dates([3]int{2024, 1, 1}),
+		},
+		{
+			name: "every third day",
+			seq: func() (iter.Seq[LocalDate], error) {
+				return EachNDays(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.January, 10), 3)
+			},
+			want: dates([3]int{2024, 1, 1}, [3]int{2024, 1, 4}, [3]int{2024, 1, 7}, [3]int{2024, 1, 10}),
+		},
+		{
+			name: "weekly stops before passing to",
+			seq: func() (iter.Seq[LocalDate], error) {
+				return EachWeek(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.January, 20))
+			},
+			want: dates([3]int{2024, 1, 1}, [3]int{2024, 1, 8}, [3]int{2024, 1, 15}),
+		},
+		{
+			name: "weekly backward",
+			seq: func() (iter.Seq[LocalDate], error) {
+				return EachWeek(NewLocalDate(2024, time.January, 15), NewLocalDate(2024, time.January, 1))
+			},
+			want: dates([3]int{2024, 1, 15}, [3]int{2024, 1, 8}, [3]int{2024, 1, 1}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq, err := tt.seq()
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got := slices.Collect(seq); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEachMonth(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   LocalDate
+		to     LocalDate
+		policy OverflowPolicy
+		want   []LocalDate
+	}{
+		{
+			name:   "clamp from month end",
+			from:   NewLocalDate(2024, time.January, 31),
+			to:     NewLocalDate(2024, time.May, 1),
+			policy: OverflowClamp,
+			want:   dates([3]int{2024, 1, 31}, [3]int{2024, 2, 29}, [3]int{2024, 3, 31}, [3]int{2024, 4, 30}),
+		},
+		{
+			name:   "end of month",
+			from:   NewLocalDate(2024, time.February, 29),
+			to:     NewLocalDate(2024, time.May, 31),
+			policy: OverflowEndOfMonth,
+			want:   dates([3]int{2024, 2, 29}, [3]int{2024, 3, 31}, [3]int{2024, 4, 30}, [3]int{2024, 5, 31}),
+		},
+		{
+			name:   "carry",
+			from:   NewLocalDate(2023, time.January, 31),
+			to:     NewLocalDate(2023, time.April, 30),
+			policy: OverflowCarry,
+			want:   dates([3]int{2023, 1, 31}, [3]int{2023, 3, 3}, [3]int{2023, 3, 31}),
+		},
+		{
+			name:   "error skips missing days",
+			from:   NewLocalDate(2023, time.January, 31),
+			to:     NewLocalDate(2023, time.June, 30),
+			policy: OverflowError,
+			want:   dates([3]int{2023, 1, 31}, [3]int{2023, 3, 31}, [3]int{2023, 5, 31}),
+		},
+		{
+			name:   "backward",
+			from:   NewLocalDate(2024, time.March, 31),
+			to:     NewLocalDate(2023, time.December, 31),
+			policy: OverflowClamp,
+			want:   dates([3]int{2024, 3, 31}, [3]int{2024, 2, 29}, [3]int{2024, 1, 31}, [3]int{2023, 12, 31}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq, err := EachMonth(tt.from, tt.to, tt.policy)
+			if err != nil {
+				t.Fatalf("EachMonth() error = %v", err)
+			}
+			if got := slices.Collect(seq); !slices.Equal(got, tt.want) {
+				t.Errorf("EachMonth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEachMonthAtRangeLimits(t *testing.T) {
+	last := LocalDate{Days: math.MaxInt32 - 1, Valid: true}
+	first := LocalDate{Days: math.MinInt32 + 1, Valid: true}
+
+	tests := []struct {
+		name string
+		from LocalDate
+		to   LocalDate
+	}{
+		{name: "forward to the last date", from: AddDays(last.StartOfMonth(), -40), to: last},
+		{name: "backward to the first date", from: AddDays(first.StartOfMonth(), 40), to: first},
+	}
+
+	for _, tt := range tests {
+		for _, policy := range []OverflowPolicy{OverflowClamp, OverflowCarry, OverflowError, OverflowEndOfMonth} {
+			t.Run(tt.name, func(t *testing.T) {
+				seq, err := EachMonth(tt.from, tt.to, policy)
+				if err != nil {
+					t.Fatalf("EachMonth() error = %v", err)
+				}
+				var got []LocalDate
+				for d := range seq {
+					if !d.IsFinite() || len(got) > 3 {
+						t.Fatalf("EachMonth(%v, %v, %v) yielded %v after %v", tt.from, tt.to, policy, d, got)
+					}
+					got = append(got, d)
+				}
+				if len(got) == 0 || got[0] != tt.from {
+					t.Errorf("EachMonth(%v, %v, %v) = %v", tt.from, tt.to, policy, got)
+				}
+			})
+		}
+	}
+}
+
+func TestEachYear(t *testing.T) {
+	seq, err := EachYear(NewLocalDate(2020, time.February, 29), NewLocalDate(2024, time.December, 31), OverflowClamp)
+	if err != nil {
+		t.Fatalf("EachYear() error = %v", err)
+	}
+	want := dates([3]int{2020, 2, 29}, [3]int{2021, 2, 28}, [3]int{2022, 2, 28}, [3]int{2023, 2, 28}, [3]int{2024, 2, 29})
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachYear() = %v, want %v", got, want)
+	}
+
+	seq, err = EachYear(NewLocalDate(2024, time.February, 29), NewLocalDate(2016, time.January, 1), OverflowError)
+	if err != nil {
+		t.Fatalf("EachYear() error = %v", err)
+	}
+	want = dates([3]int{2024, 2, 29}, [3]int{2020, 2, 29}, [3]int{2016, 2, 29})
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachYear() = %v, want %v", got, want)
+	}
+}
+
+func TestEachEarlyExit(t *testing.T) {
+	seq, err := EachDay(NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.December, 31))
+	if err != nil {
+		t.Fatalf("EachDay() error = %v", err)
+	}
+	var got []LocalDate
+	for d := range seq {
+		if d.Day() == 4 {
+			break
+		}
+		got = append(got, d)
+	}
+	if want := dates([3]int{2024, 1, 1}, [3]int{2024, 1, 2}, [3]int{2024, 1, 3}); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestEachErrors(t *testing.T) {
+	d := NewLocalDate(2024, time.January, 1)
+
+	tests := []struct {
+		name    string
+		seq     func() (iter.Seq[LocalDate], error)
+		wantErr error
+	}{
+		{
+			name:    "day to infinity",
+			seq:     func() (iter.Seq[LocalDate], error) { return EachDay(d, InfinityDate()) },
+			wantErr: ErrInfiniteDate,
+		},
+		{
+			name:    "week from negative infinity",
+			seq:     func() (iter.Seq[LocalDate], error) { return EachWeek(NegInfinityDate(), d) },
+			wantErr: ErrInfiniteDate,
+		},
+		{
+			name:    "month to infinity",
+			seq:     func() (iter.Seq[LocalDate], error) { return EachMonth(d, InfinityDate(), OverflowClamp) },
+			wantErr: ErrInfiniteDate,
+		},
+		{
+			name:    "year from invalid",
+			seq:     func() (iter.Seq[LocalDate], error) { return EachYear(LocalDate{}, d, OverflowClamp) },
+			wantErr: ErrInvalidDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq, err := tt.seq()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if seq != nil {
+				t.Errorf("expected nil iterator")
+			}
+		})
+	}
+
+	if _, err := EachNDays(d, d, 0); err == nil {
+		t.Errorf("Expected error for zero step")
+	}
+	if _, err := EachMonth(d, d, OverflowPolicy(42)); err == nil {
+		t.Errorf("Expected error for unknown policy")
+	}
+}
@@ -0,0 +1,53 @@
+package localdate
+
+import "time"
+
+// SwedishCalendar returns the Swedish public holidays (allmänna helgdagar),
+// following the changes of 1939, 1953 and 2005. Sundays, which are also
+// public holidays by law, are left to the weekend handling of the caller.
+//
+// If includeDeFacto is set the calendar also contains the eves that are
+// treated like Sundays by the Working Hours Act and most collective
+// agreements, i.e. Påskafton, Pingstafton, Midsommarafton, Julafton and
+// Nyårsafton, with Holiday.DeFacto set.
+func SwedishCalendar(includeDeFacto bool) *Calendar {
+	rules := []HolidayRule{
+		FixedDate("Nyårsdagen", time.January, 1),
+		FixedDate("Trettondedag jul", time.January, 6),
+		EasterOffset("Långfredagen", -2),
+		EasterOffset("Påskdagen", 0),
+		EasterOffset("Annandag påsk", 1),
+		ValidDuring(FixedDate("Första maj", time.May, 1), yearsFrom(1939)),
+		EasterOffset("Kristi himmelsfärdsdag", 39),
+		EasterOffset("Pingstdagen", 49),
+		ValidDuring(EasterOffset("Annandag pingst", 50), yearsUntil(2004)),
+		ValidDuring(FixedDate("Sveriges nationaldag", time.June, 6), yearsFrom(2005)),
+		swedishMidsummer("Midsommardagen", 0),
+		ValidDuring(WeekdayOnOrAfter("Alla helgons dag", time.October, 31, time.Saturday), yearsFrom(1953)),
+		ValidDuring(FixedDate("Alla helgons dag", time.November, 1), yearsUntil(1952)),
+		FixedDate("Juldagen", time.December, 25),
+		FixedDate("Annandag jul", time.December, 26),
+	}
+	if includeDeFacto {
+		rules = append(rules,
+			DeFacto(EasterOffset("Påskafton", -1)),
+			DeFacto(EasterOffset("Pingstafton", 48)),
+			DeFacto(swedishMidsummer("Midsommarafton", -1)),
+			DeFacto(FixedDate("Julafton", time.December, 24)),
+			DeFacto(FixedDate("Nyårsafton", time.December, 31)),
+		)
+	}
+	return NewCalendar(rules...)
+}
+
+// swedishMidsummer returns a rule for the day offset days from Midsummer Day,
+// which is the Saturday between June 20 and 26 since 1953 and June 24 before.
+func swedishMidsummer(name string, offset int) HolidayRule {
+	midsummer := func(year int) LocalDate {
+		if year < 1953 {
+			return NewLocalDate(year, time.June, 24)
+		}
+		return WeekdayBetween(time.Saturday, time.June, 20)(year)
+	}
+	return FeastDay(name, Feast(midsummer).Offset(offset))
+}
@@ -0,0 +1,126 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSwedishCalendar(t *testing.T) {
+	tests := []struct {
+		name    string
+		defacto bool
+		year    int
+		want    []string
+	}{
+		{
+			name: "2024",
+			year: 2024,
+			want: []string{
+				"2024-01-01 Nyårsdagen",
+				"2024-01-06 Trettondedag jul",
+				"2024-03-29 Långfredagen",
+				"2024-03-31 Påskdagen",
+				"2024-04-01 Annandag påsk",
+				"2024-05-01 Första maj",
+				"2024-05-09 Kristi himmelsfärdsdag",
+				"2024-05-19 Pingstdagen",
+				"2024-06-06 Sveriges nationaldag",
+				"2024-06-22 Midsommardagen",
+				"2024-11-02 Alla helgons dag",
+				"2024-12-25 Juldagen",
+				"2024-12-26 Annandag jul",
+			},
+		},
+		{
+			name:    "2024 with de facto holidays",
+			defacto: true,
+			year:    2024,
+			want: []string{
+				"2024-01-01 Nyårsdagen",
+				"2024-01-06 Trettondedag jul",
+				"2024-03-29 Långfredagen",
+				"2024-03-30 Påskafton",
+				"2024-03-31 Påskdagen",
+				"2024-04-01 Annandag påsk",
+				"2024-05-01 Första maj",
+				"2024-05-09 Kristi himmelsfärdsdag",
+				"2024-05-18 Pingstafton",
+				"2024-05-19 Pingstdagen",
+				"2024-06-06 Sveriges nationaldag",
+				"2024-06-21 Midsommarafton",
+				"2024-06-22 Midsommardagen",
+				"2024-11-02 Alla helgons dag",
+				"2024-12-24 Julafton",
+				"2024-12-25 Juldagen",
+				"2024-12-26 Annandag jul",
+				"2024-12-31 Nyårsafton",
+			},
+		},
+		{
+			name: "2004 has Annandag pingst but no national day",
+			year: 2004,
+			want: []string{
+				"2004-01-01 Nyårsdagen",
+				"2004-01-06 Trettondedag jul",
+				"2004-04-09 Långfredagen",
+				"2004-04-11 Påskdagen",
+				"2004-04-12 Annandag påsk",
+				"2004-05-01 Första maj",
+				"2004-05-20 Kristi himmelsfärdsdag",
+				"2004-05-30 Pingstdagen",
+				"2004-05-31 Annandag pingst",
+				"2004-06-26 Midsommardagen",
+				"2004-11-06 Alla helgons dag",
+				"2004-12-25 Juldagen",
+				"2004-12-26 Annandag jul",
+			},
+		},
+		{
+			name: "1938 before Första maj and the 1953 reform",
+			year: 1938,
+			want: []string{
+				"1938-01-01 Nyårsdagen",
+				"1938-01-06 Trettondedag jul",
+				"1938-04-15 Långfredagen",
+				"1938-04-17 Påskdagen",
+				"1938-04-18 Annandag påsk",
+				"1938-05-26 Kristi himmelsfärdsdag",
+				"1938-06-05 Pingstdagen",
+				"1938-06-06 Annandag pingst",
+				"1938-06-24 Midsommardagen",
+				"1938-11-01 Alla helgons dag",
+				"1938-12-25 Juldagen",
+				"1938-12-26 Annandag jul",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays := SwedishCalendar(tt.defacto).Holidays(tt.year)
+			if got := holidayDates(holidays); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSwedishCalendarDeFacto(t *testing.T) {
+	cal := SwedishCalendar(true)
+	for _, h := range cal.Holidays(2025) {
+		want := slices.Contains([]string{"Påskafton", "Pingstafton", "Midsommarafton", "Julafton", "Nyårsafton"}, h.Name)
+		if h.DeFacto != want {
+			t.Errorf("%v DeFacto = %v, want %v", h.Name, h.DeFacto, want)
+		}
+	}
+
+	official := SwedishCalendar(false)
+	midsummerEve := NewLocalDate(2025, time.June, 20)
+	if official.IsHoliday(midsummerEve) {
+		t.Errorf("Expected Midsommarafton not to be an official holiday")
+	}
+	if name, ok := cal.HolidayName(midsummerEve); !ok || name != "Midsommarafton" {
+		t.Errorf("HolidayName(%v) = %q, %v, want Midsommarafton", midsummerEve, name, ok)
+	}
+}
@@ -0,0 +1,108 @@
+package localdate
+
+import (
+	"fmt"
+	"time"
+)
+
+// UKRegion is a part of the United Kingdom with its own bank holidays.
+type UKRegion int
+
+const (
+	EnglandAndWales UKRegion = iota
+	Scotland
+	NorthernIreland
+)
+
+// UKCalendar returns the bank holidays of the UK region as published by the
+// UK government, including substitute days and the one-off holidays since
+// 1978. Holidays falling on a weekend are included together with their
+// substitute day.
+func UKCalendar(region UKRegion) (*Calendar, error) {
+	var rules []HolidayRule
+	switch region {
+	case EnglandAndWales:
+		rules = []HolidayRule{
+			WithSubstitutes(SubstituteNextWeekday,
+				FixedDate("New Year's Day", time.January, 1),
+				FixedDate("Christmas Day", time.December, 25),
+				FixedDate("Boxing Day", time.December, 26),
+			),
+			EasterOffset("Easter Monday", 1),
+			NthWeekday("Summer bank holiday", time.August, time.Monday, -1),
+		}
+	case Scotland:
+		rules = []HolidayRule{
+			WithSubstitutes(SubstituteNextWeekday,
+				FixedDate("New Year's Day", time.January, 1),
+				FixedDate("2nd January", time.January, 2),
+				FixedDate("Christmas Day", time.December, 25),
+				FixedDate("Boxing Day", time.December, 26),
+			),
+			NthWeekday("Summer bank holiday", time.August, time.Monday, 1),
+			ValidDuring(WithSubstitutes(SubstituteNextWeekday,
+				FixedDate("St Andrew's Day", time.November, 30),
+			), yearsFrom(2007)),
+		}
+	case NorthernIreland:
+		rules = []HolidayRule{
+			WithSubstitutes(SubstituteNextWeekday,
+				FixedDate("New Year's Day", time.January, 1),
+				FixedDate("Christmas Day", time.December, 25),
+				FixedDate("Boxing Day", time.December, 26),
+			),
+			WithSubstitutes(SubstituteNextWeekday, FixedDate("St Patrick's Day", time.March, 17)),
+			EasterOffset("Easter Monday", 1),
+			WithSubstitutes(SubstituteNextWeekday, FixedDate("Battle of the Boyne (Orangemen's Day)", time.July, 12)),
+			NthWeekday("Summer bank holiday", time.August, time.Monday, -1),
+		}
+	default:
+		return nil, fmt.Errorf("localdate: unknown UK region %d", region)
+	}
+
+	rules = append(rules,
+		EasterOffset("Good Friday", -2),
+		RuleFunc(ukEarlyMayBankHoliday),
+		RuleFunc(ukSpringBankHoliday),
+		OneOff("Royal wedding", NewLocalDate(1981, time.July, 29)),
+		OneOff("Millennium celebrations", NewLocalDate(1999, time.December, 31)),
+		OneOff("Golden Jubilee of Queen Elizabeth II", NewLocalDate(2002, time.June, 3)),
+		OneOff("Royal wedding", NewLocalDate(2011, time.April, 29)),
+		OneOff("Diamond Jubilee of Queen Elizabeth II", NewLocalDate(2012, time.June, 5)),
+		OneOff("Platinum Jubilee of Queen Elizabeth II", NewLocalDate(2022, time.June, 3)),
+		OneOff("State Funeral of Queen Elizabeth II", NewLocalDate(2022, time.September, 19)),
+		OneOff("Coronation of King Charles III", NewLocalDate(2023, time.May, 8)),
+	)
+	return NewCalendar(rules...), nil
+}
+
+// ukEarlyMayBankHoliday is the first Monday of May since 1978, moved to VE Day
+// for its 50th and 75th anniversaries.
+func ukEarlyMayBankHoliday(year int) []Holiday {
+	if year < 1978 {
+		return nil
+	}
+	var d LocalDate
+	switch year {
+	case 1995, 2020:
+		d = NewLocalDate(year, time.May, 8)
+	default:
+		d = NewLocalDate(year, time.May, 1).OnOrAfter(time.Monday)
+	}
+	return []Holiday{{Date: d, Name: "Early May bank holiday"}}
+}
+
+// ukSpringBankHoliday is the last Monday of May, moved to make a long weekend
+// with the jubilees of Queen Elizabeth II.
+func ukSpringBankHoliday(year int) []Holiday {
+	var d LocalDate
+	switch year {
+	case 2002, 2012:
+		d = NewLocalDate(year, time.June, 4)
+	case 2022:
+		d = NewLocalDate(year, time.June, 2)
+	default:
+		d = NewLocalDate(year, time.May, 31).OnOrBefore(time.Monday)
+	}
+	return []Holiday{{Date: d, Name: "Spring bank holiday"}}
+}
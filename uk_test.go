@@ -0,0 +1,140 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUKCalendar(t *testing.T) {
+	tests := []struct {
+		name   string
+		region UKRegion
+		year   int
+		want   []string
+	}{
+		{
+			name:   "England and Wales 2022",
+			region: EnglandAndWales,
+			year:   2022,
+			want: []string{
+				"2022-01-01 New Year's Day",
+				"2022-01-03 New Year's Day (substitute day)",
+				"2022-04-15 Good Friday",
+				"2022-04-18 Easter Monday",
+				"2022-05-02 Early May bank holiday",
+				"2022-06-02 Spring bank holiday",
+				"2022-06-03 Platinum Jubilee of Queen Elizabeth II",
+				"2022-08-29 Summer bank holiday",
+				"2022-09-19 State Funeral of Queen Elizabeth II",
+				"2022-12-25 Christmas Day",
+				"2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (substitute day)",
+			},
+		},
+		{
+			name:   "England and Wales 2020",
+			region: EnglandAndWales,
+			year:   2020,
+			want: []string{
+				"2020-01-01 New Year's Day",
+				"2020-04-10 Good Friday",
+				"2020-04-13 Easter Monday",
+				"2020-05-08 Early May bank holiday",
+				"2020-05-25 Spring bank holiday",
+				"2020-08-31 Summer bank holiday",
+				"2020-12-25 Christmas Day",
+				"2020-12-26 Boxing Day",
+				"2020-12-28 Boxing Day (substitute day)",
+			},
+		},
+		{
+			name:   "England and Wales 2023",
+			region: EnglandAndWales,
+			year:   2023,
+			want: []string{
+				"2023-01-01 New Year's Day",
+				"2023-01-02 New Year's Day (substitute day)",
+				"2023-04-07 Good Friday",
+				"2023-04-10 Easter Monday",
+				"2023-05-01 Early May bank holiday",
+				"2023-05-08 Coronation of King Charles III",
+				"2023-05-29 Spring bank holiday",
+				"2023-08-28 Summer bank holiday",
+				"2023-12-25 Christmas Day",
+				"2023-12-26 Boxing Day",
+			},
+		},
+		{
+			name:   "Scotland 2022",
+			region: Scotland,
+			year:   2022,
+			want: []string{
+				"2022-01-01 New Year's Day",
+				"2022-01-02 2nd January",
+				"2022-01-03 New Year's Day (substitute day)",
+				"2022-01-04 2nd January (substitute day)",
+				"2022-04-15 Good Friday",
+				"2022-05-02 Early May bank holiday",
+				"2022-06-02 Spring bank holiday",
+				"2022-06-03 Platinum Jubilee of Queen Elizabeth II",
+				"2022-08-01 Summer bank holiday",
+				"2022-09-19 State Funeral of Queen Elizabeth II",
+				"2022-11-30 St Andrew's Day",
+				"2022-12-25 Christmas Day",
+				"2022-12-26 Boxing Day",
+				"2022-12-27 Christmas Day (substitute day)",
+			},
+		},
+		{
+			name:   "Scotland 2025",
+			region: Scotland,
+			year:   2025,
+			want: []string{
+				"2025-01-01 New Year's Day",
+				"2025-01-02 2nd January",
+				"2025-04-18 Good Friday",
+				"2025-05-05 Early May bank holiday",
+				"2025-05-26 Spring bank holiday",
+				"2025-08-04 Summer bank holiday",
+				"2025-11-30 St Andrew's Day",
+				"2025-12-01 St Andrew's Day (substitute day)",
+				"2025-12-25 Christmas Day",
+				"2025-12-26 Boxing Day",
+			},
+		},
+		{
+			name:   "Northern Ireland 2024",
+			region: NorthernIreland,
+			year:   2024,
+			want: []string{
+				"2024-01-01 New Year's Day",
+				"2024-03-17 St Patrick's Day",
+				"2024-03-18 St Patrick's Day (substitute day)",
+				"2024-03-29 Good Friday",
+				"2024-04-01 Easter Monday",
+				"2024-05-06 Early May bank holiday",
+				"2024-05-27 Spring bank holiday",
+				"2024-07-12 Battle of the Boyne (Orangemen's Day)",
+				"2024-08-26 Summer bank holiday",
+				"2024-12-25 Christmas Day",
+				"2024-12-26 Boxing Day",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal, err := UKCalendar(tt.region)
+			if err != nil {
+				t.Fatalf("UKCalendar(%v) error = %v", tt.region, err)
+			}
+			if got := holidayDates(cal.Holidays(tt.year)); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := UKCalendar(UKRegion(3)); err == nil {
+		t.Errorf("Expected error for unknown region")
+	}
+}
@@ -0,0 +1,70 @@
+package localdate
+
+import (
+	"fmt"
+	"time"
+)
+
+// NextWeekday returns the first date strictly after d that falls on wd.
+// Infinite and invalid dates are returned unchanged.
+func (d LocalDate) NextWeekday(wd time.Weekday) LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return AddDays(d, 1).OnOrAfter(wd)
+}
+
+// PrevWeekday returns the last date strictly before d that falls on wd.
+// Infinite and invalid dates are returned unchanged.
+func (d LocalDate) PrevWeekday(wd time.Weekday) LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return AddDays(d, -1).OnOrBefore(wd)
+}
+
+// OnOrAfter returns the first date on or after d that falls on wd. Infinite
+// and invalid dates are returned unchanged.
+func (d LocalDate) OnOrAfter(wd time.Weekday) LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return AddDays(d, int(floorMod(int64(wd-d.Weekday()), 7)))
+}
+
+// OnOrBefore returns the last date on or before d that falls on wd. Infinite
+// and invalid dates are returned unchanged.
+func (d LocalDate) OnOrBefore(wd time.Weekday) LocalDate {
+	if !d.IsFinite() {
+		return d
+	}
+	return AddDays(d, -int(floorMod(int64(d.Weekday()-wd), 7)))
+}
+
+// StartOfWeek returns the first day of the week containing d, for weeks
+// starting on firstDay, e.g. time.Monday for ISO weeks. Infinite and invalid
+// dates are returned unchanged.
+func (d LocalDate) StartOfWeek(firstDay time.Weekday) LocalDate {
+	return d.OnOrBefore(firstDay)
+}
+
+// NthWeekdayOfMonth returns the n:th wd of the month, e.g. the third Wednesday
+// for n = 3. Negative n counts from the end of the month, so n = -1 is the
+// last wd. It returns ErrNonexistentDate if the month has no such day, e.g. a
+// fifth Monday, and an error if n is zero.
+func NthWeekdayOfMonth(year int, month time.Month, wd time.Weekday, n int) (LocalDate, error) {
+	ym := NewYearMonth(year, month)
+	var d LocalDate
+	switch {
+	case n > 0:
+		d = AddDays(ym.FirstDay().OnOrAfter(wd), (n-1)*7)
+	case n < 0:
+		d = AddDays(ym.LastDay().OnOrBefore(wd), (n+1)*7)
+	default:
+		return LocalDate{}, fmt.Errorf("localdate: weekday occurrence must not be zero")
+	}
+	if !ym.Contains(d) {
+		return LocalDate{}, fmt.Errorf("%w: no %v number %d in %v", ErrNonexistentDate, wd, n, ym)
+	}
+	return d, nil
+}
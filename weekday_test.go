@@ -0,0 +1,82 @@
+package localdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWeekdayNavigation(t *testing.T) {
+	// 2024-05-15 is a Wednesday
+	wed := NewLocalDate(2024, time.May, 15)
+
+	tests := []struct {
+		name string
+		got  LocalDate
+		want LocalDate
+	}{
+		{name: "next friday", got: wed.NextWeekday(time.Friday), want: NewLocalDate(2024, time.May, 17)},
+		{name: "next wednesday is a week later", got: wed.NextWeekday(time.Wednesday), want: NewLocalDate(2024, time.May, 22)},
+		{name: "next tuesday", got: wed.NextWeekday(time.Tuesday), want: NewLocalDate(2024, time.May, 21)},
+		{name: "prev monday", got: wed.PrevWeekday(time.Monday), want: NewLocalDate(2024, time.May, 13)},
+		{name: "prev wednesday is a week earlier", got: wed.PrevWeekday(time.Wednesday), want: NewLocalDate(2024, time.May, 8)},
+		{name: "on or after same day", got: wed.OnOrAfter(time.Wednesday), want: wed},
+		{name: "on or after sunday", got: wed.OnOrAfter(time.Sunday), want: NewLocalDate(2024, time.May, 19)},
+		{name: "on or before same day", got: wed.OnOrBefore(time.Wednesday), want: wed},
+		{name: "on or before thursday", got: wed.OnOrBefore(time.Thursday), want: NewLocalDate(2024, time.May, 9)},
+		{name: "start of ISO week", got: wed.StartOfWeek(time.Monday), want: NewLocalDate(2024, time.May, 13)},
+		{name: "start of US week", got: wed.StartOfWeek(time.Sunday), want: NewLocalDate(2024, time.May, 12)},
+		{name: "start of week on first day", got: NewLocalDate(2024, time.May, 13).StartOfWeek(time.Monday), want: NewLocalDate(2024, time.May, 13)},
+		{name: "across year", got: NewLocalDate(2024, time.December, 31).NextWeekday(time.Friday), want: NewLocalDate(2025, time.January, 3)},
+		{name: "before epoch", got: NewLocalDate(1969, time.December, 31).PrevWeekday(time.Sunday), want: NewLocalDate(1969, time.December, 28)},
+		{name: "infinity unchanged", got: InfinityDate().NextWeekday(time.Monday), want: InfinityDate()},
+		{name: "negative infinity unchanged", got: NegInfinityDate().OnOrBefore(time.Monday), want: NegInfinityDate()},
+		{name: "invalid unchanged", got: LocalDate{}.PrevWeekday(time.Monday), want: LocalDate{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		name    string
+		year    int
+		month   time.Month
+		wd      time.Weekday
+		n       int
+		want    LocalDate
+		wantErr error
+	}{
+		{name: "third wednesday", year: 2024, month: time.May, wd: time.Wednesday, n: 3, want: NewLocalDate(2024, time.May, 15)},
+		{name: "first day of month", year: 2024, month: time.May, wd: time.Wednesday, n: 1, want: NewLocalDate(2024, time.May, 1)},
+		{name: "fifth wednesday", year: 2024, month: time.May, wd: time.Wednesday, n: 5, want: NewLocalDate(2024, time.May, 29)},
+		{name: "last friday", year: 2024, month: time.May, wd: time.Friday, n: -1, want: NewLocalDate(2024, time.May, 31)},
+		{name: "second to last monday", year: 2024, month: time.May, wd: time.Monday, n: -2, want: NewLocalDate(2024, time.May, 20)},
+		{name: "thanksgiving", year: 2024, month: time.November, wd: time.Thursday, n: 4, want: NewLocalDate(2024, time.November, 28)},
+		{name: "fifth monday missing", year: 2024, month: time.May, wd: time.Monday, n: 5, wantErr: ErrNonexistentDate},
+		{name: "sixth from end missing", year: 2024, month: time.May, wd: time.Monday, n: -6, wantErr: ErrNonexistentDate},
+		{name: "leap february", year: 2024, month: time.February, wd: time.Thursday, n: 5, want: NewLocalDate(2024, time.February, 29)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NthWeekdayOfMonth(tt.year, tt.month, tt.wd, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NthWeekdayOfMonth() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NthWeekdayOfMonth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NthWeekdayOfMonth(2024, time.May, time.Monday, 0); err == nil {
+		t.Errorf("Expected error for n = 0")
+	}
+}
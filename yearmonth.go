@@ -0,0 +1,204 @@
+package localdate
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// YearMonth is a calendar month such as 2024-05, for values that only have
+// month granularity. The zero value is NULL, marshaling to JSON null and SQL
+// NULL. Use NewYearMonth to get a normalized value.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// NewYearMonth returns the given month, normalizing months outside
+// [January, December] the way time.Date does, so month 13 of 2024 is 2025-01.
+func NewYearMonth(year int, month time.Month) YearMonth {
+	return yearMonthFromIndex(int64(year)*12 + int64(month-1))
+}
+
+func yearMonthFromIndex(n int64) YearMonth {
+	return YearMonth{Year: int(floorDiv(n, 12)), Month: time.Month(floorMod(n, 12) + 1)}
+}
+
+// index returns the number of months since January of year 0.
+func (ym YearMonth) index() int64 {
+	return int64(ym.Year)*12 + int64(ym.Month-1)
+}
+
+// YearMonth returns the month of d, or the zero YearMonth when d is not finite.
+func (d LocalDate) YearMonth() YearMonth {
+	year, month, _ := d.Date()
+	return YearMonth{Year: year, Month: month}
+}
+
+// IsZero reports whether ym is the zero value, i.e. NULL.
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// FirstDay returns the first day of ym, or an invalid date if ym is zero.
+func (ym YearMonth) FirstDay() LocalDate {
+	if ym.IsZero() {
+		return LocalDate{}
+	}
+	return LocalDate{Days: int32(daysFromCivil(int64(ym.Year), ym.Month, 1)), Valid: true}
+}
+
+// LastDay returns the last day of ym, or an invalid date if ym is zero.
+func (ym YearMonth) LastDay() LocalDate {
+	if ym.IsZero() {
+		return LocalDate{}
+	}
+	return LocalDate{Days: int32(daysFromCivil(int64(ym.Year), ym.Month, ym.Days())), Valid: true}
+}
+
+// Days returns the number of days in ym, or 0 if ym is zero.
+func (ym YearMonth) Days() int {
+	if ym.IsZero() {
+		return 0
+	}
+	return daysInMonth(int64(ym.Year), ym.Month)
+}
+
+// Contains reports whether d is a finite date in ym.
+func (ym YearMonth) Contains(d LocalDate) bool {
+	return !ym.IsZero() && d.IsFinite() && d.YearMonth() == ym
+}
+
+// AddMonths returns ym with months added. The zero YearMonth is returned
+// unchanged.
+func (ym YearMonth) AddMonths(months int) YearMonth {
+	if ym.IsZero() {
+		return ym
+	}
+	return yearMonthFromIndex(ym.index() + int64(months))
+}
+
+// AddYears returns ym with years added. The zero YearMonth is returned
+// unchanged.
+func (ym YearMonth) AddYears(years int) YearMonth {
+	return ym.AddMonths(years * 12)
+}
+
+// Next returns the month after ym.
+func (ym YearMonth) Next() YearMonth {
+	return ym.AddMonths(1)
+}
+
+// Prev returns the month before ym.
+func (ym YearMonth) Prev() YearMonth {
+	return ym.AddMonths(-1)
+}
+
+// Compare returns -1, 0 or 1 depending on whether ym is before, equal to or
+// after other. The zero YearMonth sorts before all others.
+func (ym YearMonth) Compare(other YearMonth) int {
+	return compareUnits(ym, other)
+}
+
+// Sub returns the number of months from other to ym.
+func (ym YearMonth) Sub(other YearMonth) int {
+	return int(ym.index() - other.index())
+}
+
+// Dates returns an iterator over the dates of ym in ascending order.
+func (ym YearMonth) Dates() iter.Seq[LocalDate] {
+	return unitDates(ym)
+}
+
+// EachYearMonth returns an iterator over the months from from to to, both
+// included, iterating backward if to is before from. It returns
+// ErrInvalidDate if either month is zero.
+func EachYearMonth(from, to YearMonth) (iter.Seq[YearMonth], error) {
+	return eachUnit(from, to, yearMonthFromIndex)
+}
+
+// String returns ym in the format 2006-01, or an empty string if ym is zero.
+func (ym YearMonth) String() string {
+	b, _ := ym.AppendText(make([]byte, 0, 7))
+	return string(b)
+}
+
+// AppendText implements encoding.TextAppender using the same format as String.
+func (ym YearMonth) AppendText(b []byte) ([]byte, error) {
+	if ym.IsZero() {
+		return b, nil
+	}
+	b = appendYear(b, ym.Year)
+	b = append(b, '-')
+	return appendInt(b, int(ym.Month), 2), nil
+}
+
+// appendYear appends year zero padded to four digits, with a minus sign if
+// it is negative.
+func appendYear(b []byte, year int) []byte {
+	if year < 0 {
+		b = append(b, '-')
+		year = -year
+	}
+	return appendInt(b, year, 4)
+}
+
+// parseYear parses a year written by appendYear.
+func parseYear(s string) (int, bool) {
+	digits := strings.TrimPrefix(s, "-")
+	if len(digits) < 4 || strings.Trim(digits, "0123456789") != "" {
+		return 0, false
+	}
+	year, err := strconv.Atoi(s)
+	return year, err == nil
+}
+
+// ParseYearMonth parses a month in the format 2006-01.
+func ParseYearMonth(s string) (YearMonth, error) {
+	i := strings.LastIndexByte(s, '-')
+	if i <= 0 || len(s)-i != 3 {
+		return YearMonth{}, fmt.Errorf("invalid year-month %q", s)
+	}
+	year, ok := parseYear(s[:i])
+	month, err := strconv.Atoi(s[i+1:])
+	if !ok || err != nil || s[i+1] == '+' || month < 1 || month > 12 {
+		return YearMonth{}, fmt.Errorf("invalid year-month %q", s)
+	}
+	return YearMonth{Year: year, Month: time.Month(month)}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the same format as
+// String, so the zero YearMonth marshals to empty text.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return ym.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is the zero
+// YearMonth.
+func (ym *YearMonth) UnmarshalText(text []byte) error {
+	return unmarshalUnitText(ym, text, ParseYearMonth)
+}
+
+// MarshalJSON encodes the zero YearMonth as null
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	return marshalUnitJSON(ym)
+}
+
+// UnmarshalJSON decodes null as the zero YearMonth
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	return unmarshalUnitJSON(ym, data, ParseYearMonth)
+}
+
+// SQL scanning. Accepts a month in the format 2006-01 or a date that is the
+// first day of a month. NULL scans as the zero YearMonth.
+func (ym *YearMonth) Scan(value interface{}) error {
+	return scanUnit(ym, value, "YearMonth", ParseYearMonth, LocalDate.YearMonth)
+}
+
+// SQL value. The month is stored as its first day, the zero YearMonth as NULL.
+func (ym YearMonth) Value() (driver.Value, error) {
+	return ym.FirstDay().Value()
+}
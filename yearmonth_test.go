@@ -0,0 +1,256 @@
+package localdate
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestNewYearMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  YearMonth
+	}{
+		{year: 2024, month: time.May, want: YearMonth{2024, time.May}},
+		{year: 2024, month: 13, want: YearMonth{2025, time.January}},
+		{year: 2024, month: 0, want: YearMonth{2023, time.December}},
+		{year: 2024, month: -12, want: YearMonth{2022, time.December}},
+	}
+
+	for _, tt := range tests {
+		if got := NewYearMonth(tt.year, tt.month); got != tt.want {
+			t.Errorf("NewYearMonth(%d, %d) = %v, want %v", tt.year, tt.month, got, tt.want)
+		}
+	}
+
+	if got := NewLocalDate(2024, time.May, 17).YearMonth(); got != (YearMonth{2024, time.May}) {
+		t.Errorf("YearMonth() = %v, want 2024-05", got)
+	}
+	if got := InfinityDate().YearMonth(); !got.IsZero() {
+		t.Errorf("InfinityDate().YearMonth() = %v, want zero", got)
+	}
+}
+
+func TestYearMonthDays(t *testing.T) {
+	tests := []struct {
+		ym        YearMonth
+		wantFirst LocalDate
+		wantLast  LocalDate
+		wantDays  int
+	}{
+		{YearMonth{2024, time.February}, NewLocalDate(2024, time.February, 1), NewLocalDate(2024, time.February, 29), 29},
+		{YearMonth{2023, time.February}, NewLocalDate(2023, time.February, 1), NewLocalDate(2023, time.February, 28), 28},
+		{YearMonth{2024, time.December}, NewLocalDate(2024, time.December, 1), NewLocalDate(2024, time.December, 31), 31},
+		{YearMonth{}, LocalDate{}, LocalDate{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ym.String(), func(t *testing.T) {
+			if got := tt.ym.FirstDay(); got != tt.wantFirst {
+				t.Errorf("FirstDay() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := tt.ym.LastDay(); got != tt.wantLast {
+				t.Errorf("LastDay() = %v, want %v", got, tt.wantLast)
+			}
+			if got := tt.ym.Days(); got != tt.wantDays {
+				t.Errorf("Days() = %v, want %v", got, tt.wantDays)
+			}
+			if got := len(slices.Collect(tt.ym.Dates())); got != tt.wantDays {
+				t.Errorf("len(Dates()) = %v, want %v", got, tt.wantDays)
+			}
+		})
+	}
+
+	ym := YearMonth{2024, time.May}
+	if !ym.Contains(NewLocalDate(2024, time.May, 31)) {
+		t.Errorf("Expected 2024-05 to contain 2024-05-31")
+	}
+	if ym.Contains(NewLocalDate(2024, time.June, 1)) || ym.Contains(InfinityDate()) {
+		t.Errorf("Expected 2024-05 not to contain 2024-06-01 or infinity")
+	}
+	if (YearMonth{}).Contains(LocalDate{}) {
+		t.Errorf("Expected zero YearMonth to contain nothing")
+	}
+}
+
+func TestYearMonthArithmetic(t *testing.T) {
+	ym := YearMonth{2024, time.November}
+
+	tests := []struct {
+		name string
+		got  YearMonth
+		want YearMonth
+	}{
+		{name: "add months", got: ym.AddMonths(3), want: YearMonth{2025, time.February}},
+		{name: "subtract months", got: ym.AddMonths(-23), want: YearMonth{2022, time.December}},
+		{name: "add years", got: ym.AddYears(-2), want: YearMonth{2022, time.November}},
+		{name: "next", got: YearMonth{2024, time.December}.Next(), want: YearMonth{2025, time.January}},
+		{name: "prev", got: YearMonth{2024, time.January}.Prev(), want: YearMonth{2023, time.December}},
+		{name: "zero unchanged", got: YearMonth{}.Next(), want: YearMonth{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := (YearMonth{2025, time.February}).Sub(ym); got != 3 {
+		t.Errorf("Sub() = %v, want 3", got)
+	}
+	if got := ym.Compare(YearMonth{2025, time.January}); got != -1 {
+		t.Errorf("Compare() = %v, want -1", got)
+	}
+	if got := ym.Compare(YearMonth{}); got != 1 {
+		t.Errorf("Compare(zero) = %v, want 1", got)
+	}
+}
+
+func TestEachYearMonth(t *testing.T) {
+	seq, err := EachYearMonth(YearMonth{2023, time.November}, YearMonth{2024, time.February})
+	if err != nil {
+		t.Fatalf("EachYearMonth() error = %v", err)
+	}
+	want := []YearMonth{{2023, time.November}, {2023, time.December}, {2024, time.January}, {2024, time.February}}
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachYearMonth() = %v, want %v", got, want)
+	}
+
+	seq, err = EachYearMonth(YearMonth{2024, time.February}, YearMonth{2023, time.December})
+	if err != nil {
+		t.Fatalf("EachYearMonth() error = %v", err)
+	}
+	want = []YearMonth{{2024, time.February}, {2024, time.January}, {2023, time.December}}
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachYearMonth() = %v, want %v", got, want)
+	}
+
+	if _, err := EachYearMonth(YearMonth{}, want[0]); err == nil {
+		t.Errorf("Expected error for zero YearMonth")
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    YearMonth
+		wantErr bool
+	}{
+		{input: "2024-05", want: YearMonth{2024, time.May}},
+		{input: "0001-12", want: YearMonth{1, time.December}},
+		{input: "-0044-03", want: YearMonth{-44, time.March}},
+		{input: "12345-01", want: YearMonth{12345, time.January}},
+		{input: "2024-13", wantErr: true},
+		{input: "2024-00", wantErr: true},
+		{input: "2024-5", wantErr: true},
+		{input: "2024-+5", wantErr: true},
+		{input: "24-05", wantErr: true},
+		{input: "2024-05-01", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseYearMonth(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseYearMonth(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseYearMonth(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.input {
+				t.Errorf("String() = %v, want %v", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestYearMonthJSON(t *testing.T) {
+	type row struct {
+		Period YearMonth         `json:"period"`
+		Next   YearMonth         `json:"next,omitzero"`
+		Keyed  map[YearMonth]int `json:"keyed"`
+	}
+
+	in := row{Period: YearMonth{2024, time.May}, Keyed: map[YearMonth]int{{2024, time.June}: 1}}
+	got, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"period":"2024-05","keyed":{"2024-06":1}}`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+
+	var out row
+	if err := json.Unmarshal(got, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out.Period != in.Period || out.Keyed[YearMonth{2024, time.June}] != 1 {
+		t.Errorf("Unmarshal() = %+v, want %+v", out, in)
+	}
+
+	null, err := json.Marshal(YearMonth{})
+	if err != nil || string(null) != "null" {
+		t.Errorf("Marshal(zero) = %s, %v, want null", null, err)
+	}
+	ym := YearMonth{2024, time.May}
+	if err := json.Unmarshal([]byte("null"), &ym); err != nil || !ym.IsZero() {
+		t.Errorf("Unmarshal(null) = %v, %v, want zero", ym, err)
+	}
+	if err := json.Unmarshal([]byte(`"2024-5"`), &ym); err == nil {
+		t.Errorf("Expected error for malformed month")
+	}
+}
+
+func TestYearMonthSQL(t *testing.T) {
+	want := YearMonth{2024, time.May}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    YearMonth
+		wantErr bool
+	}{
+		{name: "year-month string", input: "2024-05", want: want},
+		{name: "first day string", input: "2024-05-01", want: want},
+		{name: "bytes", input: []byte("2024-05-01"), want: want},
+		{name: "time", input: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), want: want},
+		{name: "pgtype date", input: NewLocalDate(2024, time.May, 1).PgDate(), want: want},
+		{name: "pgtype null", input: pgtype.Date{}, want: YearMonth{}},
+		{name: "nil", input: nil, want: YearMonth{}},
+		{name: "not first day", input: "2024-05-02", wantErr: true},
+		{name: "infinity", input: "infinity", wantErr: true},
+		{name: "garbage", input: "May 2024", wantErr: true},
+		{name: "unsupported", input: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := YearMonth{2000, time.January}
+			err := got.Scan(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, want 2024-05-01", value)
+	}
+	if value, err := (YearMonth{}).Value(); value != nil || err != nil {
+		t.Errorf("Value(zero) = %v, %v, want nil", value, err)
+	}
+}
@@ -0,0 +1,229 @@
+package localdate
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+)
+
+// YearWeek is an ISO 8601 week such as 2024-W05. Weeks start on Monday and
+// week 1 is the week containing the year's first Thursday, so the week-based
+// year can differ from the calendar year around New Year. The zero value is
+// NULL. Use NewYearWeek to get a normalized value.
+type YearWeek struct {
+	Year int
+	Week int
+}
+
+// isoWeekday returns the ISO day number of d, 1 for Monday through 7 for
+// Sunday. d must be finite.
+func isoWeekday(days int64) int64 {
+	// 1970-01-01 was a Thursday
+	return floorMod(days+3, 7) + 1
+}
+
+// firstMonday returns the Monday of week 1 of the ISO week-based year.
+func firstMonday(year int64) int64 {
+	jan4 := daysFromCivil(year, time.January, 4)
+	return jan4 - isoWeekday(jan4) + 1
+}
+
+// ISOWeek returns the ISO 8601 year and week number of d, like
+// time.Time.ISOWeek. It returns 0, 0 when d is not finite.
+func (d LocalDate) ISOWeek() (year, week int) {
+	if !d.IsFinite() {
+		return 0, 0
+	}
+	days := int64(d.Days)
+	// the week belongs to the year of its Thursday
+	thursday := days - isoWeekday(days) + 4
+	year, _, _ = civilFromDays(thursday)
+	return year, int((thursday-firstMonday(int64(year)))/7) + 1
+}
+
+// YearWeek returns the ISO week of d, or the zero YearWeek when d is not
+// finite.
+func (d LocalDate) YearWeek() YearWeek {
+	year, week := d.ISOWeek()
+	return YearWeek{Year: year, Week: week}
+}
+
+// ISOWeekDate returns d in the ISO 8601 week date format, e.g. 2024-W05-3 for
+// Wednesday 2024-01-31, or an empty string if d is not finite.
+func (d LocalDate) ISOWeekDate() string {
+	if !d.IsFinite() {
+		return ""
+	}
+	b, _ := d.YearWeek().AppendText(make([]byte, 0, 10))
+	b = append(b, '-', byte('0'+isoWeekday(int64(d.Days))))
+	return string(b)
+}
+
+// ParseISOWeekDate parses a date in the ISO 8601 week date format, e.g.
+// 2024-W05-3.
+func ParseISOWeekDate(s string) (LocalDate, error) {
+	i := strings.LastIndexByte(s, '-')
+	if i < 0 || len(s)-i != 2 || s[i+1] < '1' || s[i+1] > '7' {
+		return LocalDate{}, fmt.Errorf("invalid ISO week date %q", s)
+	}
+	yw, err := ParseYearWeek(s[:i])
+	if err != nil {
+		return LocalDate{}, fmt.Errorf("invalid ISO week date %q", s)
+	}
+	return AddDays(yw.FirstDay(), int(s[i+1]-'1')), nil
+}
+
+// NewYearWeek returns the given ISO week, normalizing weeks outside the
+// year's range, so week 53 of 2023, which only has 52 weeks, is 2024-W01.
+func NewYearWeek(year, week int) YearWeek {
+	monday := firstMonday(int64(year)) + int64(week-1)*7
+	return LocalDate{Days: int32(monday), Valid: true}.YearWeek()
+}
+
+// WeeksInYear returns the number of ISO weeks in the week-based year, 52 or 53.
+func WeeksInYear(year int) int {
+	return int(firstMonday(int64(year)+1)-firstMonday(int64(year))) / 7
+}
+
+// IsZero reports whether yw is the zero value, i.e. NULL.
+func (yw YearWeek) IsZero() bool {
+	return yw == YearWeek{}
+}
+
+// FirstDay returns the Monday of yw, or an invalid date if yw is zero.
+func (yw YearWeek) FirstDay() LocalDate {
+	if yw.IsZero() {
+		return LocalDate{}
+	}
+	return LocalDate{Days: int32(yw.monday()), Valid: true}
+}
+
+// LastDay returns the Sunday of yw, or an invalid date if yw is zero.
+func (yw YearWeek) LastDay() LocalDate {
+	return yw.Day(time.Sunday)
+}
+
+// Day returns the given weekday of yw, or an invalid date if yw is zero.
+func (yw YearWeek) Day(wd time.Weekday) LocalDate {
+	if yw.IsZero() {
+		return LocalDate{}
+	}
+	// time.Weekday starts the week on Sunday
+	offset := (int64(wd) + 6) % 7
+	return LocalDate{Days: int32(yw.monday() + offset), Valid: true}
+}
+
+func (yw YearWeek) monday() int64 {
+	return firstMonday(int64(yw.Year)) + int64(yw.Week-1)*7
+}
+
+// index returns the number of weeks from the week of 1970-01-01 to yw.
+func (yw YearWeek) index() int64 {
+	return floorDiv(yw.monday(), 7)
+}
+
+func yearWeekFromIndex(n int64) YearWeek {
+	// 1970-01-05 was the first Monday after the epoch
+	return LocalDate{Days: int32(n*7 + 4), Valid: true}.YearWeek()
+}
+
+// Contains reports whether d is a finite date in yw.
+func (yw YearWeek) Contains(d LocalDate) bool {
+	return !yw.IsZero() && d.IsFinite() && d.YearWeek() == yw
+}
+
+// Dates returns an iterator over the dates of yw from Monday to Sunday.
+func (yw YearWeek) Dates() iter.Seq[LocalDate] {
+	return unitDates(yw)
+}
+
+// AddWeeks returns yw with weeks added. The zero YearWeek is returned
+// unchanged.
+func (yw YearWeek) AddWeeks(weeks int) YearWeek {
+	if yw.IsZero() {
+		return yw
+	}
+	return NewYearWeek(yw.Year, yw.Week+weeks)
+}
+
+// Next returns the week after yw.
+func (yw YearWeek) Next() YearWeek {
+	return yw.AddWeeks(1)
+}
+
+// Prev returns the week before yw.
+func (yw YearWeek) Prev() YearWeek {
+	return yw.AddWeeks(-1)
+}
+
+// Compare returns -1, 0 or 1 depending on whether yw is before, equal to or
+// after other. The zero YearWeek sorts before all others.
+func (yw YearWeek) Compare(other YearWeek) int {
+	return compareUnits(yw, other)
+}
+
+// Sub returns the number of weeks from other to yw.
+func (yw YearWeek) Sub(other YearWeek) int {
+	return int(yw.index() - other.index())
+}
+
+// EachYearWeek returns an iterator over the weeks from from to to, both
+// included, iterating backward if to is before from. It returns
+// ErrInvalidDate if either week is zero.
+func EachYearWeek(from, to YearWeek) (iter.Seq[YearWeek], error) {
+	return eachUnit(from, to, yearWeekFromIndex)
+}
+
+// String returns yw in the format 2006-W01, or an empty string if yw is zero.
+func (yw YearWeek) String() string {
+	b, _ := yw.AppendText(make([]byte, 0, 8))
+	return string(b)
+}
+
+// AppendText implements encoding.TextAppender using the same format as String.
+func (yw YearWeek) AppendText(b []byte) ([]byte, error) {
+	if yw.IsZero() {
+		return b, nil
+	}
+	b = appendYear(b, yw.Year)
+	b = append(b, "-W"...)
+	return appendInt(b, yw.Week, 2), nil
+}
+
+// ParseYearWeek parses an ISO week in the format 2006-W01. The week must exist
+// in the given year.
+func ParseYearWeek(s string) (YearWeek, error) {
+	i := strings.LastIndex(s, "-W")
+	if i <= 0 || len(s)-i != 4 || strings.Trim(s[i+2:], "0123456789") != "" {
+		return YearWeek{}, fmt.Errorf("invalid ISO week %q", s)
+	}
+	year, ok := parseYear(s[:i])
+	week := int(s[i+2]-'0')*10 + int(s[i+3]-'0')
+	if !ok || week < 1 || week > WeeksInYear(year) {
+		return YearWeek{}, fmt.Errorf("invalid ISO week %q", s)
+	}
+	return YearWeek{Year: year, Week: week}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the same format as
+// String, so the zero YearWeek marshals to empty text.
+func (yw YearWeek) MarshalText() ([]byte, error) {
+	return yw.AppendText(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text is the zero
+// YearWeek.
+func (yw *YearWeek) UnmarshalText(text []byte) error {
+	return unmarshalUnitText(yw, text, ParseYearWeek)
+}
+
+// MarshalJSON encodes the zero YearWeek as null
+func (yw YearWeek) MarshalJSON() ([]byte, error) {
+	return marshalUnitJSON(yw)
+}
+
+// UnmarshalJSON decodes null as the zero YearWeek
+func (yw *YearWeek) UnmarshalJSON(data []byte) error {
+	return unmarshalUnitJSON(yw, data, ParseYearWeek)
+}
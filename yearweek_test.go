@@ -0,0 +1,251 @@
+package localdate
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestISOWeek(t *testing.T) {
+	tests := []struct {
+		date     LocalDate
+		wantYear int
+		wantWeek int
+	}{
+		{date: NewLocalDate(2024, time.January, 1), wantYear: 2024, wantWeek: 1},
+		{date: NewLocalDate(2023, time.January, 1), wantYear: 2022, wantWeek: 52},
+		{date: NewLocalDate(2021, time.January, 3), wantYear: 2020, wantWeek: 53},
+		{date: NewLocalDate(2024, time.December, 30), wantYear: 2025, wantWeek: 1},
+		{date: NewLocalDate(2024, time.January, 31), wantYear: 2024, wantWeek: 5},
+		{date: InfinityDate(), wantYear: 0, wantWeek: 0},
+		{date: LocalDate{}, wantYear: 0, wantWeek: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			year, week := tt.date.ISOWeek()
+			if year != tt.wantYear || week != tt.wantWeek {
+				t.Errorf("ISOWeek() = %v, %v, want %v, %v", year, week, tt.wantYear, tt.wantWeek)
+			}
+		})
+	}
+}
+
+func TestISOWeekMatchesTime(t *testing.T) {
+	start := NewLocalDate(1895, time.December, 20)
+	for i := range 365 * 300 {
+		d := AddDays(start, i*7/5)
+		year, week := d.ISOWeek()
+		wantYear, wantWeek := d.Time().ISOWeek()
+		if year != wantYear || week != wantWeek {
+			t.Fatalf("%v.ISOWeek() = %v, %v, want %v, %v", d, year, week, wantYear, wantWeek)
+		}
+	}
+}
+
+func TestYearWeek(t *testing.T) {
+	tests := []struct {
+		yw        YearWeek
+		wantFirst LocalDate
+		wantLast  LocalDate
+	}{
+		{YearWeek{2024, 1}, NewLocalDate(2024, time.January, 1), NewLocalDate(2024, time.January, 7)},
+		{YearWeek{2020, 53}, NewLocalDate(2020, time.December, 28), NewLocalDate(2021, time.January, 3)},
+		{YearWeek{2025, 1}, NewLocalDate(2024, time.December, 30), NewLocalDate(2025, time.January, 5)},
+		{YearWeek{}, LocalDate{}, LocalDate{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.yw.String(), func(t *testing.T) {
+			if got := tt.yw.FirstDay(); got != tt.wantFirst {
+				t.Errorf("FirstDay() = %v, want %v", got, tt.wantFirst)
+			}
+			if got := tt.yw.LastDay(); got != tt.wantLast {
+				t.Errorf("LastDay() = %v, want %v", got, tt.wantLast)
+			}
+			dates := slices.Collect(tt.yw.Dates())
+			if !tt.yw.IsZero() && (len(dates) != 7 || dates[0] != tt.wantFirst || dates[6] != tt.wantLast) {
+				t.Errorf("Dates() = %v", dates)
+			}
+			for _, d := range dates {
+				if !tt.yw.Contains(d) {
+					t.Errorf("Contains(%v) = false, want true", d)
+				}
+			}
+		})
+	}
+
+	yw := YearWeek{2024, 5}
+	if got, want := yw.Day(time.Wednesday), NewLocalDate(2024, time.January, 31); got != want {
+		t.Errorf("Day(Wednesday) = %v, want %v", got, want)
+	}
+	if yw.Contains(NewLocalDate(2024, time.February, 5)) {
+		t.Errorf("Expected 2024-W05 not to contain 2024-02-05")
+	}
+}
+
+func TestWeeksInYear(t *testing.T) {
+	for year, want := range map[int]int{2015: 53, 2020: 53, 2021: 52, 2024: 52, 2026: 53} {
+		if got := WeeksInYear(year); got != want {
+			t.Errorf("WeeksInYear(%d) = %v, want %v", year, got, want)
+		}
+	}
+}
+
+func TestYearWeekArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  YearWeek
+		want YearWeek
+	}{
+		{name: "normalize", got: NewYearWeek(2023, 53), want: YearWeek{2024, 1}},
+		{name: "normalize week zero", got: NewYearWeek(2021, 0), want: YearWeek{2020, 53}},
+		{name: "add across 53 week year", got: YearWeek{2020, 52}.AddWeeks(2), want: YearWeek{2021, 1}},
+		{name: "subtract", got: YearWeek{2024, 2}.AddWeeks(-3), want: YearWeek{2023, 51}},
+		{name: "next", got: YearWeek{2020, 53}.Next(), want: YearWeek{2021, 1}},
+		{name: "prev", got: YearWeek{2021, 1}.Prev(), want: YearWeek{2020, 53}},
+		{name: "zero unchanged", got: YearWeek{}.Next(), want: YearWeek{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := (YearWeek{2021, 2}).Sub(YearWeek{2020, 52}); got != 3 {
+		t.Errorf("Sub() = %v, want 3", got)
+	}
+	if got := (YearWeek{2020, 53}).Compare(YearWeek{2021, 1}); got != -1 {
+		t.Errorf("Compare() = %v, want -1", got)
+	}
+}
+
+func TestEachYearWeek(t *testing.T) {
+	seq, err := EachYearWeek(YearWeek{2020, 52}, YearWeek{2021, 2})
+	if err != nil {
+		t.Fatalf("EachYearWeek() error = %v", err)
+	}
+	want := []YearWeek{{2020, 52}, {2020, 53}, {2021, 1}, {2021, 2}}
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachYearWeek() = %v, want %v", got, want)
+	}
+
+	seq, err = EachYearWeek(YearWeek{2021, 1}, YearWeek{2020, 53})
+	if err != nil {
+		t.Fatalf("EachYearWeek() error = %v", err)
+	}
+	want = []YearWeek{{2021, 1}, {2020, 53}}
+	if got := slices.Collect(seq); !slices.Equal(got, want) {
+		t.Errorf("EachYearWeek() = %v, want %v", got, want)
+	}
+
+	if _, err := EachYearWeek(YearWeek{}, YearWeek{2021, 1}); err == nil {
+		t.Errorf("Expected error for zero YearWeek")
+	}
+}
+
+func TestParseYearWeek(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    YearWeek
+		wantErr bool
+	}{
+		{input: "2024-W05", want: YearWeek{2024, 5}},
+		{input: "2020-W53", want: YearWeek{2020, 53}},
+		{input: "2021-W53", wantErr: true},
+		{input: "2024-W00", wantErr: true},
+		{input: "2024-W5", wantErr: true},
+		{input: "2024W05", wantErr: true},
+		{input: "2024-w05", wantErr: true},
+		{input: "2024-W05-3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseYearWeek(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseYearWeek(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseYearWeek(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.input {
+				t.Errorf("String() = %v, want %v", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestParseISOWeekDate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    LocalDate
+		wantErr bool
+	}{
+		{input: "2024-W05-3", want: NewLocalDate(2024, time.January, 31)},
+		{input: "2025-W01-1", want: NewLocalDate(2024, time.December, 30)},
+		{input: "2020-W53-7", want: NewLocalDate(2021, time.January, 3)},
+		{input: "2024-W05-8", wantErr: true},
+		{input: "2024-W05-0", wantErr: true},
+		{input: "2024-W05", wantErr: true},
+		{input: "2021-W53-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseISOWeekDate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseISOWeekDate(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseISOWeekDate(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if !tt.wantErr && got.ISOWeekDate() != tt.input {
+				t.Errorf("ISOWeekDate() = %v, want %v", got.ISOWeekDate(), tt.input)
+			}
+		})
+	}
+
+	if got := InfinityDate().ISOWeekDate(); got != "" {
+		t.Errorf("InfinityDate().ISOWeekDate() = %q, want empty", got)
+	}
+}
+
+func TestYearWeekMarshaling(t *testing.T) {
+	type row struct {
+		Week YearWeek `json:"week"`
+		Next YearWeek `json:"next"`
+	}
+
+	in := row{Week: YearWeek{2024, 5}}
+	got, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"week":"2024-W05","next":null}`; string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+
+	out := row{Next: YearWeek{2000, 1}}
+	if err := json.Unmarshal(got, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out != in {
+		t.Errorf("Unmarshal() = %+v, want %+v", out, in)
+	}
+
+	var yw YearWeek
+	if err := yw.UnmarshalText([]byte("2020-W53")); err != nil || yw != (YearWeek{2020, 53}) {
+		t.Errorf("UnmarshalText() = %v, %v", yw, err)
+	}
+	if err := yw.UnmarshalText(nil); err != nil || !yw.IsZero() {
+		t.Errorf("UnmarshalText(empty) = %v, %v, want zero", yw, err)
+	}
+	if text, _ := (YearWeek{}).MarshalText(); len(text) != 0 {
+		t.Errorf("MarshalText(zero) = %q, want empty", text)
+	}
+}